@@ -20,7 +20,7 @@ type Address struct {
 	Street string `valid:"required"`
 	City   string `valid:"required"`
 	Planet string `valid:"required"`
-	Phone  string `valid:"required"`
+	Phone  string `valid:"required,phone=HK|CN|US"`
 }
 
 func main() {
@@ -3,6 +3,7 @@ package validator
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"sync"
@@ -21,7 +22,11 @@ type field struct {
 	requiredTags     requiredTags
 	validTags        otherValidTags
 	typ              reflect.Type
-	omitEmpty        bool
+	omit             omitMode
+	isFile           bool
+	isFileList       bool
+	// refError reports a rule of the tag naming a field that the struct does not have.
+	refError error
 }
 
 // A ValidTag represents parse validTag into field struct.
@@ -30,6 +35,8 @@ type ValidTag struct {
 	params            []string
 	messageName       string
 	messageParameters MessageParameters
+	// numeric marks the size rules of a string field with a numeric rule, which compare the value of the string.
+	numeric bool
 }
 
 // A otherValidTags represents parse validTag into field struct when validTag is not required...
@@ -91,7 +98,17 @@ func getFieldName(sf reflect.StructField, f *field) string {
 func createFieldFromStructField(sf reflect.StructField, f *field, t, ft reflect.Type, index []int, validTag string) field {
 	name := getFieldName(sf, f)
 	tagged := sf.Tag.Get("json") != "" && f.isvalidTag(sf.Tag.Get("json"))
-	requiredTags, otherValidTags, defaultAttribute := f.parseTagIntoSlice(validTag, ft)
+	requiredTags, otherValidTags, defaultAttribute, omit := f.parseTagIntoSlice(validTag, ft)
+	isFile, isFileList := isFileType(sf.Type), isFileListType(sf.Type)
+	if contentFile, contentFileList := contentFileType(sf.Type, validTag); contentFile || contentFileList {
+		isFile, isFileList = contentFile, contentFileList
+		// The size rules measure the file in kilobytes rather than count elements.
+		for _, tag := range otherValidTags {
+			if isFileSizeRule(tag.name) {
+				tag.messageName = tag.name + ".file"
+			}
+		}
+	}
 
 	return field{
 		name:             name,
@@ -105,12 +122,15 @@ func createFieldFromStructField(sf reflect.StructField, f *field, t, ft reflect.
 		requiredTags:     requiredTags,
 		validTags:        otherValidTags,
 		typ:              ft,
-		omitEmpty:        strings.Contains(validTag, "omitempty"),
+		omit:             omit,
+		isFile:           isFile,
+		isFileList:       isFileList,
+		refError:         otherValidTags.checkFieldRefs(t, sf.Name),
 	}
 }
 
 // processStructField processes a single struct field and updates fields/next accordingly
-func processStructField(sf reflect.StructField, f *field, t reflect.Type, i int, count, nextCount map[reflect.Type]int, fields, next *[]field) {
+func processStructField(sf reflect.StructField, f *field, t reflect.Type, i int, nextCount map[reflect.Type]int, fields, next *[]field) {
 	if shouldSkipField(sf) {
 		return
 	}
@@ -138,17 +158,8 @@ func processStructField(sf reflect.StructField, f *field, t reflect.Type, i int,
 
 	// Record found field and index sequence.
 	if name != sf.Name || !sf.Anonymous || ft.Kind() != reflect.Struct {
-		count[f.typ]++
 		newField := createFieldFromStructField(sf, f, t, ft, index, validTag)
 		*fields = append(*fields, newField)
-
-		if count[f.typ] > 1 {
-			// If there were multiple instances, add a second,
-			// so that the annihilation code will see a duplicate.
-			// It only cares about the distinction between 1 or 2,
-			// so don't bother generating any more copies.
-			*fields = append(*fields, (*fields)[len(*fields)-1])
-		}
 		return
 	}
 
@@ -177,7 +188,7 @@ func typefields(t reflect.Type) []field {
 
 	for len(next) > 0 {
 		current, next = next, current[:0]
-		count, nextCount := nextCount, map[reflect.Type]int{}
+		nextCount = map[reflect.Type]int{}
 
 		for _, f := range current {
 			if visited[f.typ] {
@@ -186,7 +197,7 @@ func typefields(t reflect.Type) []field {
 			visited[f.typ] = true
 			for i := 0; i < f.typ.NumField(); i++ {
 				sf := f.typ.Field(i)
-				processStructField(sf, &f, t, i, count, nextCount, &fields, &next)
+				processStructField(sf, &f, t, i, nextCount, &fields, &next)
 			}
 		}
 	}
@@ -194,16 +205,17 @@ func typefields(t reflect.Type) []field {
 	return fields
 }
 
-func (f *field) parseTagIntoSlice(tag string, ft reflect.Type) (requiredTags, otherValidTags, string) {
+func (f *field) parseTagIntoSlice(tag string, ft reflect.Type) (requiredTags, otherValidTags, string, omitMode) {
 	options := strings.Split(tag, ",")
 	var otherValidTags otherValidTags
 	var requiredTags requiredTags
 	defaultAttribute := ""
+	omit := omitNone
 
 	for _, option := range options {
 		option = strings.TrimSpace(option)
 
-		tag := strings.Split(option, "=")
+		tag := strings.SplitN(option, "=", 2)
 		var params []string
 
 		if len(tag) == 2 {
@@ -216,7 +228,16 @@ func (f *field) parseTagIntoSlice(tag string, ft reflect.Type) (requiredTags, ot
 				defaultAttribute = tag[1]
 			}
 			continue
-		case "required", "requiredIf", "requiredUnless", "requiredWith", "requiredWithAll", "requiredWithout", "requiredWithoutAll":
+		case "omitempty":
+			omit = omitEmptyMode
+			continue
+		case "omitnil":
+			omit = omitNilMode
+			continue
+		case "omitzero":
+			omit = omitZeroMode
+			continue
+		case "required", "requiredIf", "requiredUnless", "requiredWith", "requiredWithAll", "requiredWithout", "requiredWithoutAll", "present":
 			messageParameters, _ := f.parseMessageParameterIntoSlice(tag[0], params...)
 			requiredTags = append(requiredTags, &ValidTag{
 				name:              tag[0],
@@ -227,16 +248,95 @@ func (f *field) parseTagIntoSlice(tag string, ft reflect.Type) (requiredTags, ot
 			continue
 		}
 
-		messageParameters, _ := f.parseMessageParameterIntoSlice(tag[0], params...)
+		messageParams, messageName := params, f.parseMessageName(tag[0], ft)
+		if lengthRules[tag[0]] {
+			messageParams, messageName = f.parseLengthMode(messageName, params)
+		}
+		messageParameters, _ := f.parseMessageParameterIntoSlice(tag[0], messageParams...)
 		otherValidTags = append(otherValidTags, &ValidTag{
 			name:              tag[0],
 			params:            params,
-			messageName:       f.parseMessageName(tag[0], ft),
+			messageName:       messageName,
 			messageParameters: messageParameters,
 		})
 	}
 
-	return requiredTags, otherValidTags, defaultAttribute
+	otherValidTags.markNumeric(ft)
+
+	return requiredTags, otherValidTags, defaultAttribute, omit
+}
+
+// checkFieldRefs returns an error naming the first rule that refers to a field the struct type t does not have, such
+// as a mistyped same=PasswrodConfirm or confirmed without a PasswordConfirmation field.
+func (tags otherValidTags) checkFieldRefs(t reflect.Type, fieldName string) error {
+	for _, tag := range tags {
+		ref := ""
+		switch tag.name {
+		case "same", "different", "inArray", "notInArray", "subsetOf":
+			if len(tag.params) == 1 {
+				ref = tag.params[0]
+			}
+		case "gt", "gte", "lt", "lte":
+			if len(tag.params) > 0 {
+				param, _, _, err := splitLengthMode(tag.params[0])
+				if _, numErr := ToFloat(param); err == nil && numErr != nil {
+					ref = param
+				}
+			}
+		case "confirmed":
+			ref = fieldName + "Confirmation"
+		}
+		if ref != "" && !hasFieldPath(t, ref) {
+			return fmt.Errorf("validator: %s.%s %s refers to unknown field %s", t.Name(), fieldName, tag.name, ref)
+		}
+	}
+	return nil
+}
+
+// hasFieldPath reports whether the dotted path, such as Address.City, names a field of the struct type t. Paths
+// through interfaces cannot be checked and are accepted.
+func hasFieldPath(t reflect.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() == reflect.Interface {
+			return true
+		}
+		if t.Kind() != reflect.Struct {
+			return false
+		}
+		sf, ok := t.FieldByName(name)
+		if !ok {
+			return false
+		}
+		t = sf.Type
+	}
+	return true
+}
+
+// markNumeric marks the size rules of a string field with the decimal rule to compare the value of the string.
+// With the other numeric rules they keep counting characters, as tags such as numeric,max=11 limit digits.
+func (tags otherValidTags) markNumeric(ft reflect.Type) {
+	if ft.Kind() != reflect.String || !tags.hasDecimalRule() {
+		return
+	}
+	for _, tag := range tags {
+		if lengthRules[tag.name] {
+			tag.numeric = true
+			tag.messageName = strings.TrimSuffix(tag.messageName, ".string") + ".numeric"
+		}
+	}
+}
+
+// hasDecimalRule reports whether one of the tags is the decimal rule.
+func (tags otherValidTags) hasDecimalRule() bool {
+	for _, tag := range tags {
+		if tag.name == "decimal" {
+			return true
+		}
+	}
+	return false
 }
 
 func (f *field) isvalidTag(s string) bool {
@@ -273,6 +373,12 @@ func (f *field) parseMessageName(rule string, ft reflect.Type) string {
 
 	switch rule {
 	case "between", "gt", "gte", "lt", "lte", "min", "max", "size":
+		if isFileType(ft) || isFileListType(ft) {
+			return messageName + ".file"
+		}
+		if isBigNumberType(ft) {
+			return messageName + ".numeric"
+		}
 		switch ft.Kind() {
 		case reflect.Int, reflect.Int8, reflect.Int16,
 			reflect.Int32, reflect.Int64,
@@ -294,6 +400,21 @@ func (f *field) parseMessageName(rule string, ft reflect.Type) string {
 	}
 }
 
+// parseLengthMode strips the length mode suffixes from the params of a length rule for its message parameters,
+// and returns the message name of the mode.
+func (f *field) parseLengthMode(messageName string, params []string) ([]string, string) {
+	stripped := make([]string, len(params))
+	mode := LengthRunes
+	for i, param := range params {
+		value, m, ok, _ := splitLengthMode(param)
+		if ok {
+			mode = m
+		}
+		stripped[i] = value
+	}
+	return stripped, lengthMessageName(messageName, mode)
+}
+
 type messageParameter struct {
 	Key   string
 	Value string
@@ -332,6 +453,80 @@ func (f *field) parseMessageParameterIntoSlice(rule string, params ...string) (M
 				Value: buff.String(),
 			},
 		)
+	case "dimensions":
+		// Width and Height are the dimensions of the image, so the exact constraints are ExactWidth and ExactHeight.
+		for _, param := range params {
+			name, value, ok := strings.Cut(param, ":")
+			if !ok || name == "" {
+				return nil, errors.New("validator: " + rule + " format is not valid")
+			}
+			if name == "width" || name == "height" {
+				name = "exact" + strings.ToUpper(name[:1]) + name[1:]
+			}
+			messageParameters = append(
+				messageParameters,
+				messageParameter{
+					Key:   strings.ToUpper(name[:1]) + name[1:],
+					Value: value,
+				},
+			)
+		}
+	case "mimes", "mimetypes", "ipIn", "ipNotIn", "urlHost":
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Values",
+				Value: strings.Join(params, ", "),
+			},
+		)
+	case "currencyAmount":
+		if len(params) != 1 {
+			return nil, errors.New("validator: " + rule + " format is not valid")
+		}
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Currency",
+				Value: params[0],
+			},
+		)
+	case "decimal":
+		if len(params) != 1 && len(params) != 2 {
+			return nil, errors.New("validator: " + rule + " format is not valid")
+		}
+		scale := "0"
+		if len(params) == 2 {
+			scale = params[1]
+		}
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Precision",
+				Value: params[0],
+			}, messageParameter{
+				Key:   "Scale",
+				Value: scale,
+			},
+		)
+	case "multipleOf":
+		if len(params) != 1 {
+			return nil, errors.New("validator: " + rule + " format is not valid")
+		}
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Value",
+				Value: params[0],
+			},
+		)
+	case "semverRange":
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Values",
+				Value: strings.Join(params, " || "),
+			},
+		)
 	case "between", "digitsBetween":
 		if len(params) != 2 {
 			return nil, errors.New("validator: " + rule + " format is not valid")
@@ -394,6 +589,17 @@ func (f *field) parseMessageParameterIntoSlice(rule string, params ...string) (M
 				Value: params[0],
 			},
 		)
+	case "digits":
+		if len(params) != 1 {
+			return nil, errors.New("validator: " + rule + " format is not valid")
+		}
+		messageParameters = append(
+			messageParameters,
+			messageParameter{
+				Key:   "Digits",
+				Value: params[0],
+			},
+		)
 	}
 
 	if len(messageParameters) > 0 {
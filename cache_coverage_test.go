@@ -277,7 +277,6 @@ func TestProcessStructField(t *testing.T) {
 
 	structType := reflect.TypeOf(TestStruct{})
 	f := &field{typ: structType}
-	count := make(map[reflect.Type]int)
 	nextCount := make(map[reflect.Type]int)
 	var fields []field
 	var next []field
@@ -285,7 +284,7 @@ func TestProcessStructField(t *testing.T) {
 	// Test each field
 	for i := 0; i < structType.NumField(); i++ {
 		sf := structType.Field(i)
-		processStructField(sf, f, structType, i, count, nextCount, &fields, &next)
+		processStructField(sf, f, structType, i, nextCount, &fields, &next)
 	}
 
 	// Should have processed valid fields but skipped others
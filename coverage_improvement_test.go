@@ -53,14 +53,14 @@ func TestValidateRequiredWithDirect(t *testing.T) {
 	objValue := reflect.ValueOf(testStruct)
 	field1Value := reflect.ValueOf("value1")
 
-	result := validateRequiredWith([]string{"Field2"}, field1Value, objValue)
+	result := validateRequiredWith(Empty, []string{"Field2"}, field1Value, objValue)
 	if !result {
 		t.Error("validateRequiredWith should return true when both fields are present")
 	}
 
 	// Case 2: Field2 present, Field1 empty - should be invalid
 	field1EmptyValue := reflect.ValueOf("")
-	result = validateRequiredWith([]string{"Field2"}, field1EmptyValue, objValue)
+	result = validateRequiredWith(Empty, []string{"Field2"}, field1EmptyValue, objValue)
 	if result {
 		t.Error("validateRequiredWith should return false when Field2 is present but Field1 is empty")
 	}
@@ -68,7 +68,7 @@ func TestValidateRequiredWithDirect(t *testing.T) {
 	// Case 3: Field2 empty, Field1 empty - should be valid
 	testStructEmpty := TestStruct{Field1: "", Field2: ""}
 	objValueEmpty := reflect.ValueOf(testStructEmpty)
-	result = validateRequiredWith([]string{"Field2"}, field1EmptyValue, objValueEmpty)
+	result = validateRequiredWith(Empty, []string{"Field2"}, field1EmptyValue, objValueEmpty)
 	if !result {
 		t.Error("validateRequiredWith should return true when both fields are empty")
 	}
@@ -87,14 +87,14 @@ func TestValidateRequiredWithAllDirect(t *testing.T) {
 	objValue := reflect.ValueOf(testStruct)
 	field1Value := reflect.ValueOf("value1")
 
-	result := validateRequiredWithAll([]string{"Field2", "Field3"}, field1Value, objValue)
+	result := validateRequiredWithAll(Empty, []string{"Field2", "Field3"}, field1Value, objValue)
 	if !result {
 		t.Error("validateRequiredWithAll should return true when all fields are present")
 	}
 
 	// Case 2: Field2 and Field3 present, Field1 empty - should be invalid
 	field1EmptyValue := reflect.ValueOf("")
-	result = validateRequiredWithAll([]string{"Field2", "Field3"}, field1EmptyValue, objValue)
+	result = validateRequiredWithAll(Empty, []string{"Field2", "Field3"}, field1EmptyValue, objValue)
 	if result {
 		t.Error("validateRequiredWithAll should return false when Field2 and Field3 are present but Field1 is empty")
 	}
@@ -102,7 +102,7 @@ func TestValidateRequiredWithAllDirect(t *testing.T) {
 	// Case 3: Only Field2 present, Field1 empty - should be valid (Field1 not required)
 	testStructPartial := TestStruct{Field1: "", Field2: "value2", Field3: ""}
 	objValuePartial := reflect.ValueOf(testStructPartial)
-	result = validateRequiredWithAll([]string{"Field2", "Field3"}, field1EmptyValue, objValuePartial)
+	result = validateRequiredWithAll(Empty, []string{"Field2", "Field3"}, field1EmptyValue, objValuePartial)
 	if !result {
 		t.Error("validateRequiredWithAll should return true when not all required fields are present")
 	}
@@ -120,14 +120,14 @@ func TestValidateRequiredWithoutDirect(t *testing.T) {
 	objValue := reflect.ValueOf(testStruct)
 	field1Value := reflect.ValueOf("value1")
 
-	result := validateRequiredWithout([]string{"Field2"}, field1Value, objValue)
+	result := validateRequiredWithout(Empty, []string{"Field2"}, field1Value, objValue)
 	if !result {
 		t.Error("validateRequiredWithout should return true when Field2 is absent and Field1 is present")
 	}
 
 	// Case 2: Field2 absent, Field1 absent - should be invalid
 	field1EmptyValue := reflect.ValueOf("")
-	result = validateRequiredWithout([]string{"Field2"}, field1EmptyValue, objValue)
+	result = validateRequiredWithout(Empty, []string{"Field2"}, field1EmptyValue, objValue)
 	if result {
 		t.Error("validateRequiredWithout should return false when Field2 is absent and Field1 is also absent")
 	}
@@ -135,7 +135,7 @@ func TestValidateRequiredWithoutDirect(t *testing.T) {
 	// Case 3: Field2 present, Field1 absent - should be valid (Field1 not required)
 	testStructWithField2 := TestStruct{Field1: "", Field2: "value2"}
 	objValueWithField2 := reflect.ValueOf(testStructWithField2)
-	result = validateRequiredWithout([]string{"Field2"}, field1EmptyValue, objValueWithField2)
+	result = validateRequiredWithout(Empty, []string{"Field2"}, field1EmptyValue, objValueWithField2)
 	if !result {
 		t.Error("validateRequiredWithout should return true when Field2 is present (Field1 not required)")
 	}
@@ -154,14 +154,14 @@ func TestValidateRequiredWithoutAllDirect(t *testing.T) {
 	objValue := reflect.ValueOf(testStruct)
 	field1Value := reflect.ValueOf("value1")
 
-	result := validateRequiredWithoutAll([]string{"Field2", "Field3"}, field1Value, objValue)
+	result := validateRequiredWithoutAll(Empty, []string{"Field2", "Field3"}, field1Value, objValue)
 	if !result {
 		t.Error("validateRequiredWithoutAll should return true when all other fields are absent and Field1 is present")
 	}
 
 	// Case 2: All fields absent, Field1 absent - should be invalid
 	field1EmptyValue := reflect.ValueOf("")
-	result = validateRequiredWithoutAll([]string{"Field2", "Field3"}, field1EmptyValue, objValue)
+	result = validateRequiredWithoutAll(Empty, []string{"Field2", "Field3"}, field1EmptyValue, objValue)
 	if result {
 		t.Error("validateRequiredWithoutAll should return false when all fields including Field1 are absent")
 	}
@@ -169,7 +169,7 @@ func TestValidateRequiredWithoutAllDirect(t *testing.T) {
 	// Case 3: Some field present, Field1 absent - should be valid (Field1 not required)
 	testStructPartial := TestStruct{Field1: "", Field2: "value2", Field3: ""}
 	objValuePartial := reflect.ValueOf(testStructPartial)
-	result = validateRequiredWithoutAll([]string{"Field2", "Field3"}, field1EmptyValue, objValuePartial)
+	result = validateRequiredWithoutAll(Empty, []string{"Field2", "Field3"}, field1EmptyValue, objValuePartial)
 	if !result {
 		t.Error("validateRequiredWithoutAll should return true when some other fields are present (Field1 not required)")
 	}
@@ -2,7 +2,10 @@ package validator
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -36,6 +39,31 @@ func (es Errors) Errors() []error {
 	return es
 }
 
+// Unwrap returns the errors, so that errors.Is and errors.As find any of them.
+func (es Errors) Unwrap() []error {
+	return es
+}
+
+// Is reports whether any of the errors matches the target, for versions of errors.Is that do not unwrap []error.
+func (es Errors) Is(target error) bool {
+	for _, e := range es {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the errors that matches the target, for versions of errors.As that do not unwrap []error.
+func (es Errors) As(target interface{}) bool {
+	for _, e := range es {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
 // FieldErrors returns all FieldError instances
 func (es Errors) FieldErrors() []*FieldError {
 	fieldErrors := make([]*FieldError, 0, len(es))
@@ -95,8 +123,11 @@ var errorResponsePool = sync.Pool{
 	},
 }
 
-// MarshalJSON output Json format.
+// MarshalJSON output Json format, or the format of the ErrorEncoder of the Validator that returned the errors.
 func (es Errors) MarshalJSON() ([]byte, error) {
+	if encoder := es.encoder(); encoder != nil {
+		return json.Marshal(encoder(es))
+	}
 	if len(es) == 0 {
 		return []byte("[]"), nil
 	}
@@ -122,6 +153,7 @@ func (es Errors) MarshalJSON() ([]byte, error) {
 // FieldError encapsulates name, message, and value etc.
 type FieldError struct {
 	Name              string            `json:"name"`
+	Path              []PathSegment     `json:"path,omitempty"`
 	StructName        string            `json:"struct_name,omitempty"`
 	Tag               string            `json:"tag"`
 	MessageName       string            `json:"message_name,omitempty"`
@@ -131,6 +163,9 @@ type FieldError struct {
 	Value             string            `json:"value,omitempty"`
 	Message           string            `json:"message"`
 	FuncError         error             `json:"func_error,omitempty"`
+
+	encoder ErrorEncoder
+	detail  error
 }
 
 // Unwrap implements the errors.Unwrap interface for error chain support
@@ -138,6 +173,17 @@ func (fe *FieldError) Unwrap() error {
 	return fe.FuncError
 }
 
+// Is reports whether the target is the RuleError of the rule that failed, such as ErrRequired.
+func (fe *FieldError) Is(target error) bool {
+	rule, ok := target.(RuleError)
+	return ok && string(rule) == fe.Tag
+}
+
+// As finds the RangeError or LengthError detailing the failure of a length rule.
+func (fe *FieldError) As(target interface{}) bool {
+	return fe.detail != nil && errors.As(fe.detail, target)
+}
+
 // Error returns the error message with optional function error details
 func (fe *FieldError) Error() string {
 	if fe.Message != "" {
@@ -158,3 +204,122 @@ func (fe *FieldError) HasFuncError() bool {
 func (fe *FieldError) SetMessage(msg string) {
 	fe.Message = msg
 }
+
+// ProblemContentType is the media type of the problem details documents of RFC 7807.
+const ProblemContentType = "application/problem+json"
+
+// ProblemOptions configures the problem details document of Errors.
+type ProblemOptions struct {
+	// Type is a URI reference identifying the problem type; it defaults to about:blank.
+	Type string
+	// Title defaults to the status text of Status.
+	Title string
+	// Status defaults to 422 Unprocessable Entity.
+	Status int
+	// Detail defaults to a count of the invalid parameters.
+	Detail string
+	// Instance is a URI reference identifying the occurrence of the problem.
+	Instance string
+	// Translator translates the reasons into the best match of Language, an Accept-Language header value or a
+	// language code.
+	Translator *Translator
+	Language   string
+}
+
+// ProblemDetails is an RFC 7807 problem details document with the invalid-params extension.
+type ProblemDetails struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params"`
+}
+
+// InvalidParam describes a field error in the invalid-params extension of ProblemDetails.
+type InvalidParam struct {
+	Name    string            `json:"name"`
+	Reason  string            `json:"reason"`
+	Tag     string            `json:"tag,omitempty"`
+	Params  map[string]string `json:"params,omitempty"`
+	Pointer string            `json:"pointer,omitempty"`
+}
+
+// ProblemDetails returns the errors as an RFC 7807 problem details document. The reasons are translated when the
+// options have a Translator with messages for the Language; the errors themselves are left as they are.
+func (es Errors) ProblemDetails(opts ProblemOptions) *ProblemDetails {
+	problem := &ProblemDetails{
+		Type:          opts.Type,
+		Title:         opts.Title,
+		Status:        opts.Status,
+		Detail:        opts.Detail,
+		Instance:      opts.Instance,
+		InvalidParams: make([]InvalidParam, 0, len(es)),
+	}
+	if problem.Type == "" {
+		problem.Type = "about:blank"
+	}
+	if problem.Status == 0 {
+		problem.Status = http.StatusUnprocessableEntity
+	}
+	if problem.Title == "" {
+		problem.Title = http.StatusText(problem.Status)
+	}
+
+	fieldErrors := make(Errors, 0, len(es))
+	for _, fieldErr := range es.FieldErrors() {
+		copied := *fieldErr
+		fieldErrors = append(fieldErrors, &copied)
+	}
+	if opts.Translator != nil {
+		if language := opts.Translator.Match(opts.Language); language != "" {
+			fieldErrors = opts.Translator.Trans(fieldErrors, language)
+		}
+	}
+
+	for _, e := range fieldErrors {
+		fieldErr := e.(*FieldError)
+		param := InvalidParam{
+			Name:   fieldErr.Name,
+			Reason: fieldErr.Error(),
+			Tag:    fieldErr.Tag,
+		}
+		if len(fieldErr.MessageParameters) > 0 {
+			param.Params = make(map[string]string, len(fieldErr.MessageParameters))
+			for _, p := range fieldErr.MessageParameters {
+				param.Params[p.Key] = p.Value
+			}
+		}
+		if len(fieldErr.Path) > 0 {
+			param.Pointer = JSONPointerPath(fieldErr.Path)
+		}
+		problem.InvalidParams = append(problem.InvalidParams, param)
+	}
+
+	if problem.Detail == "" {
+		if n := len(problem.InvalidParams); n == 1 {
+			problem.Detail = "1 parameter is invalid."
+		} else {
+			problem.Detail = strconv.Itoa(n) + " parameters are invalid."
+		}
+	}
+	return problem
+}
+
+// WriteProblem writes the errors to w as an application/problem+json response with the status of the document.
+func (es Errors) WriteProblem(w http.ResponseWriter, opts ProblemOptions) error {
+	problem := es.ProblemDetails(opts)
+	data, err := json.Marshal(problem)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", ProblemContentType)
+	if opts.Language != "" && opts.Translator != nil {
+		if language := opts.Translator.Match(opts.Language); language != "" {
+			w.Header().Set("Content-Language", strings.ReplaceAll(language, "_", "-"))
+		}
+	}
+	w.WriteHeader(problem.Status)
+	_, err = w.Write(data)
+	return err
+}
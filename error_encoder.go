@@ -0,0 +1,168 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// ErrorEncoder returns the document into which Errors.MarshalJSON encodes the errors. The errors returned by the
+// ValidateStruct of a Validator with an ErrorEncoder follow it; others are encoded as a list of messages and
+// parameters.
+//
+// The encoder is recorded on the FieldErrors that ValidateStruct returns, not on Errors, so that ValidateStruct keeps
+// returning Errors. Errors built by the caller follow it only if they hold at least one of those FieldErrors, as when
+// appending to them; Errors made only of new FieldErrors fall back to the list. Errors.WithEncoder chooses the
+// encoder of any Errors.
+type ErrorEncoder func(errs Errors) interface{}
+
+// ErrorMap maps the names of fields to their messages, as in {"email": ["The Email field is required."]}.
+type ErrorMap struct {
+	// Names are the names of the fields in the order of their first errors, which is their declaration order.
+	Names    []string
+	Messages map[string][]string
+}
+
+// MarshalJSON encodes the map with its keys in the order of Names.
+func (m ErrorMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, name := range m.Names {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		messages, err := json.Marshal(m.Messages[name])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(messages)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// KeyedErrors encodes the errors as an ErrorMap of the messages of each field.
+func KeyedErrors(errs Errors) interface{} {
+	m := ErrorMap{Messages: make(map[string][]string)}
+	for _, fieldErr := range errs.FieldErrors() {
+		if _, ok := m.Messages[fieldErr.Name]; !ok {
+			m.Names = append(m.Names, fieldErr.Name)
+		}
+		m.Messages[fieldErr.Name] = append(m.Messages[fieldErr.Name], fieldErr.Error())
+	}
+	return m
+}
+
+// JSONAPIDocument is a JSON:API document of errors.
+type JSONAPIDocument struct {
+	Errors []JSONAPIError `json:"errors"`
+}
+
+// JSONAPIError is an error object of JSON:API.
+type JSONAPIError struct {
+	Status string         `json:"status"`
+	Code   string         `json:"code,omitempty"`
+	Title  string         `json:"title"`
+	Detail string         `json:"detail"`
+	Source *JSONAPISource `json:"source,omitempty"`
+}
+
+// JSONAPISource points to the member of the request document that caused a JSONAPIError.
+type JSONAPISource struct {
+	Pointer string `json:"pointer"`
+}
+
+// JSONAPIErrors encodes the errors as a JSON:API document, whose source pointers point into the attributes of the
+// primary data, such as /data/attributes/addresses/0/city.
+func JSONAPIErrors(errs Errors) interface{} {
+	doc := JSONAPIDocument{Errors: make([]JSONAPIError, 0, len(errs))}
+	for _, fieldErr := range errs.FieldErrors() {
+		e := JSONAPIError{
+			Status: "422",
+			Code:   fieldErr.Tag,
+			Title:  "Invalid Attribute",
+			Detail: fieldErr.Error(),
+		}
+		if len(fieldErr.Path) > 0 {
+			e.Source = &JSONAPISource{Pointer: "/data/attributes" + JSONPointerPath(fieldErr.Path)}
+		}
+		doc.Errors = append(doc.Errors, e)
+	}
+	return doc
+}
+
+// GraphQLResponse is a GraphQL response carrying errors only.
+type GraphQLResponse struct {
+	Errors []GraphQLError `json:"errors"`
+}
+
+// GraphQLError is an error of a GraphQL response. Its path holds field names and list indices, and the code of its
+// extensions is the rule that failed.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors encodes the errors as the errors of a GraphQL response.
+func GraphQLErrors(errs Errors) interface{} {
+	resp := GraphQLResponse{Errors: make([]GraphQLError, 0, len(errs))}
+	for _, fieldErr := range errs.FieldErrors() {
+		e := GraphQLError{Message: fieldErr.Error()}
+		for _, s := range fieldErr.Path {
+			if s.Kind == IndexSegment {
+				e.Path = append(e.Path, s.Index)
+			} else {
+				e.Path = append(e.Path, s.Name)
+			}
+		}
+		if fieldErr.Tag != "" {
+			e.Extensions = map[string]interface{}{"code": fieldErr.Tag}
+		}
+		resp.Errors = append(resp.Errors, e)
+	}
+	return resp
+}
+
+// WithEncoder returns a copy of the errors that MarshalJSON encodes with the encoder, or as a list of messages and
+// parameters when it is nil, whichever Validator returned them. The FieldErrors are copied, so the errors themselves
+// keep their encoding. Errors without a FieldError are always encoded as the list.
+func (es Errors) WithEncoder(encoder ErrorEncoder) Errors {
+	encoded := make(Errors, len(es))
+	for i, e := range es {
+		if fieldErr, ok := e.(*FieldError); ok {
+			copied := *fieldErr
+			copied.encoder = encoder
+			e = &copied
+		}
+		encoded[i] = e
+	}
+	return encoded
+}
+
+// encoder returns the ErrorEncoder of the Validator that returned the errors, or nil.
+func (es Errors) encoder() ErrorEncoder {
+	for _, e := range es {
+		if fieldErr, ok := e.(*FieldError); ok && fieldErr.encoder != nil {
+			return fieldErr.encoder
+		}
+	}
+	return nil
+}
+
+// setEncoder records the ErrorEncoder of the Validator on the field errors of err.
+func (v *Validator) setEncoder(err error) {
+	switch e := err.(type) {
+	case *FieldError:
+		e.encoder = v.ErrorEncoder
+	case Errors:
+		for _, fe := range e {
+			v.setEncoder(fe)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package validator
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type encoderAddress struct {
+	City string `json:"city" valid:"required"`
+}
+
+type encoderUser struct {
+	Zip       string           `json:"zip" valid:"required"`
+	Name      string           `json:"name" valid:"required,email"`
+	Addresses []encoderAddress `json:"addresses"`
+}
+
+func TestErrorEncoders(t *testing.T) {
+	user := encoderUser{Name: "alice", Addresses: []encoderAddress{{}}}
+	var tests = []struct {
+		encoder  ErrorEncoder
+		expected string
+	}{
+		{
+			nil,
+			`[{"message":"The Zip field is required.","parameter":"zip"},{"message":"The Name must be a valid email address.","parameter":"name"},` +
+				`{"message":"The City field is required.","parameter":"addresses.0.city"}]`,
+		},
+		{
+			KeyedErrors,
+			`{"zip":["The Zip field is required."],"name":["The Name must be a valid email address."],"addresses.0.city":["The City field is required."]}`,
+		},
+		{
+			JSONAPIErrors,
+			`{"errors":[{"status":"422","code":"required","title":"Invalid Attribute","detail":"The Zip field is required.","source":{"pointer":"/data/attributes/zip"}},` +
+				`{"status":"422","code":"email","title":"Invalid Attribute","detail":"The Name must be a valid email address.","source":{"pointer":"/data/attributes/name"}},` +
+				`{"status":"422","code":"required","title":"Invalid Attribute","detail":"The City field is required.","source":{"pointer":"/data/attributes/addresses/0/city"}}]}`,
+		},
+		{
+			GraphQLErrors,
+			`{"errors":[{"message":"The Zip field is required.","path":["zip"],"extensions":{"code":"required"}},` +
+				`{"message":"The Name must be a valid email address.","path":["name"],"extensions":{"code":"email"}},` +
+				`{"message":"The City field is required.","path":["addresses",0,"city"],"extensions":{"code":"required"}}]}`,
+		},
+	}
+	for _, test := range tests {
+		v := New()
+		v.ErrorEncoder = test.encoder
+		err := v.ValidateStruct(user, nil, nil)
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		data, jsonErr := json.Marshal(err)
+		if jsonErr != nil || string(data) != test.expected {
+			t.Errorf("Expected %s, got %s %v", test.expected, data, jsonErr)
+		}
+	}
+}
+
+func TestErrorEncoderMergedErrors(t *testing.T) {
+	v := New()
+	v.ErrorEncoder = KeyedErrors
+	err := v.ValidateStruct(encoderUser{Zip: "1", Name: "alice@example.com", Addresses: []encoderAddress{{}}}, nil, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	merged := append(err.(Errors), &FieldError{Name: "token", Message: "The token is invalid."})
+	data, jsonErr := json.Marshal(merged)
+	expected := `{"addresses.0.city":["The City field is required."],"token":["The token is invalid."]}`
+	if jsonErr != nil || string(data) != expected {
+		t.Errorf("Expected %s, got %s %v", expected, data, jsonErr)
+	}
+
+	built := Errors{&FieldError{Name: "token", Message: "The token is invalid."}}
+	data, jsonErr = json.Marshal(built)
+	expected = `[{"message":"The token is invalid.","parameter":"token"}]`
+	if jsonErr != nil || string(data) != expected {
+		t.Errorf("Expected errors without an encoder to fall back to the list, got %s %v", data, jsonErr)
+	}
+
+	data, jsonErr = json.Marshal(built.WithEncoder(KeyedErrors))
+	if expected := `{"token":["The token is invalid."]}`; jsonErr != nil || string(data) != expected {
+		t.Errorf("Expected WithEncoder to choose the encoder, got %s %v", data, jsonErr)
+	}
+	if built[0].(*FieldError).encoder != nil {
+		t.Error("Expected WithEncoder to leave the errors unchanged")
+	}
+	data, jsonErr = json.Marshal(merged.WithEncoder(nil))
+	if jsonErr != nil || data[0] != '[' {
+		t.Errorf("Expected WithEncoder(nil) to restore the list, got %s %v", data, jsonErr)
+	}
+}
+
+func TestKeyedErrorsMessages(t *testing.T) {
+	errs := Errors{
+		&FieldError{Name: "b", Message: "first"},
+		&FieldError{Name: "a", Message: "second"},
+		&FieldError{Name: "b", Message: "third"},
+		errors.New("generic"),
+	}
+	data, err := json.Marshal(KeyedErrors(errs))
+	expected := `{"b":["first","third"],"a":["second"],"":["generic"]}`
+	if err != nil || string(data) != expected {
+		t.Errorf("Expected %s, got %s %v", expected, data, err)
+	}
+
+	data, err = json.Marshal(GraphQLErrors(Errors{errors.New("generic")}))
+	if expected := `{"errors":[{"message":"generic"}]}`; err != nil || string(data) != expected {
+		t.Errorf("Expected %s, got %s %v", expected, data, err)
+	}
+}
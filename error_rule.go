@@ -0,0 +1,285 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// RuleError is the sentinel error of a rule. errors.Is matches it against the FieldErrors whose Tag is the rule, so
+// that a custom rule has one as well, as in RuleError("slug").
+type RuleError string
+
+// Error returns the name of the rule.
+func (e RuleError) Error() string {
+	return "validator: " + string(e) + " rule failed"
+}
+
+// The sentinel errors of the built-in rules.
+var (
+	ErrAccepted           = RuleError("accepted")
+	ErrAlpha              = RuleError("alpha")
+	ErrAlphaDash          = RuleError("alphaDash")
+	ErrAlphaDashUnicode   = RuleError("alphaDashUnicode")
+	ErrAlphaNum           = RuleError("alphaNum")
+	ErrAlphaNumUnicode    = RuleError("alphaNumUnicode")
+	ErrAlphaUnicode       = RuleError("alphaUnicode")
+	ErrBase64             = RuleError("base64")
+	ErrBase64RawURL       = RuleError("base64RawUrl")
+	ErrBase64URL          = RuleError("base64url")
+	ErrBetween            = RuleError("between")
+	ErrBIC                = RuleError("bic")
+	ErrBoolean            = RuleError("boolean")
+	ErrCIDR               = RuleError("cidr")
+	ErrCIDRv4             = RuleError("cidrv4")
+	ErrCIDRv6             = RuleError("cidrv6")
+	ErrCNCreditCode       = RuleError("cnCreditCode")
+	ErrCNLandline         = RuleError("cnLandline")
+	ErrCNMobile           = RuleError("cnMobile")
+	ErrCNResidentID       = RuleError("cnResidentId")
+	ErrColor              = RuleError("color")
+	ErrConfirmed          = RuleError("confirmed")
+	ErrCountry2           = RuleError("country2")
+	ErrCountry3           = RuleError("country3")
+	ErrCountryNumeric     = RuleError("countryNumeric")
+	ErrCreditCard         = RuleError("creditCard")
+	ErrCurrency           = RuleError("currency")
+	ErrCurrencyAmount     = RuleError("currencyAmount")
+	ErrDecimal            = RuleError("decimal")
+	ErrDifferent          = RuleError("different")
+	ErrDigits             = RuleError("digits")
+	ErrDigitsBetween      = RuleError("digitsBetween")
+	ErrDimensions         = RuleError("dimensions")
+	ErrDistinct           = RuleError("distinct")
+	ErrE164               = RuleError("e164")
+	ErrEmail              = RuleError("email")
+	ErrFile               = RuleError("file")
+	ErrFilled             = RuleError("filled")
+	ErrFloat              = RuleError("float")
+	ErrFQDN               = RuleError("fqdn")
+	ErrGt                 = RuleError("gt")
+	ErrGte                = RuleError("gte")
+	ErrHexadecimal        = RuleError("hexadecimal")
+	ErrHexColor           = RuleError("hexColor")
+	ErrHKID               = RuleError("hkid")
+	ErrHostname           = RuleError("hostname")
+	ErrHostPort           = RuleError("hostPort")
+	ErrHSL                = RuleError("hsl")
+	ErrHSLA               = RuleError("hsla")
+	ErrHTTPURL            = RuleError("httpUrl")
+	ErrIBAN               = RuleError("iban")
+	ErrImage              = RuleError("image")
+	ErrIn                 = RuleError("in")
+	ErrInArray            = RuleError("inArray")
+	ErrInt                = RuleError("int")
+	ErrInteger            = RuleError("integer")
+	ErrIP                 = RuleError("ip")
+	ErrIPIn               = RuleError("ipIn")
+	ErrIPNotIn            = RuleError("ipNotIn")
+	ErrIPv4               = RuleError("ipv4")
+	ErrIPv6               = RuleError("ipv6")
+	ErrISBN               = RuleError("isbn")
+	ErrISBN10             = RuleError("isbn10")
+	ErrISBN13             = RuleError("isbn13")
+	ErrJSON               = RuleError("json")
+	ErrJWT                = RuleError("jwt")
+	ErrLanguage           = RuleError("language")
+	ErrLoopback           = RuleError("loopback")
+	ErrLt                 = RuleError("lt")
+	ErrLte                = RuleError("lte")
+	ErrMAC                = RuleError("mac")
+	ErrMax                = RuleError("max")
+	ErrMimes              = RuleError("mimes")
+	ErrMimetypes          = RuleError("mimetypes")
+	ErrMin                = RuleError("min")
+	ErrMultipleOf         = RuleError("multipleOf")
+	ErrNegative           = RuleError("negative")
+	ErrNonZero            = RuleError("nonZero")
+	ErrNotIn              = RuleError("notIn")
+	ErrNotInArray         = RuleError("notInArray")
+	ErrNumeric            = RuleError("numeric")
+	ErrPassword           = RuleError("password")
+	ErrPhone              = RuleError("phone")
+	ErrPort               = RuleError("port")
+	ErrPositive           = RuleError("positive")
+	ErrPostcode           = RuleError("postcode")
+	ErrPostcodeField      = RuleError("postcodeField")
+	ErrPresent            = RuleError("present")
+	ErrPrivateIP          = RuleError("privateIp")
+	ErrPublicIP           = RuleError("publicIp")
+	ErrRequired           = RuleError("required")
+	ErrRequiredIf         = RuleError("requiredIf")
+	ErrRequiredUnless     = RuleError("requiredUnless")
+	ErrRequiredWith       = RuleError("requiredWith")
+	ErrRequiredWithAll    = RuleError("requiredWithAll")
+	ErrRequiredWithout    = RuleError("requiredWithout")
+	ErrRequiredWithoutAll = RuleError("requiredWithoutAll")
+	ErrRGB                = RuleError("rgb")
+	ErrRGBA               = RuleError("rgba")
+	ErrSafeURL            = RuleError("safeUrl")
+	ErrSame               = RuleError("same")
+	ErrSemver             = RuleError("semver")
+	ErrSemverRange        = RuleError("semverRange")
+	ErrSize               = RuleError("size")
+	ErrString             = RuleError("string")
+	ErrSubsetOf           = RuleError("subsetOf")
+	ErrTimezone           = RuleError("timezone")
+	ErrULID               = RuleError("ulid")
+	ErrUnixAddr           = RuleError("unixAddr")
+	ErrURL                = RuleError("url")
+	ErrURLHost            = RuleError("urlHost")
+	ErrURLRequireScheme   = RuleError("urlRequireScheme")
+	ErrUUID               = RuleError("uuid")
+	ErrUUID1              = RuleError("uuid1")
+	ErrUUID3              = RuleError("uuid3")
+	ErrUUID4              = RuleError("uuid4")
+	ErrUUID5              = RuleError("uuid5")
+	ErrUUID6              = RuleError("uuid6")
+	ErrUUID7              = RuleError("uuid7")
+	ErrUUIDAny            = RuleError("uuidAny")
+)
+
+// RangeError details the failure of a rule bounding a number, such as between=1|10 or gt=0. Min or Max is empty when
+// the rule leaves that side open, and Exclusive is set for the bound of gt or lt. Rules comparing with another field,
+// such as lte=Max, are bounded by the value of that field, and the size rules of files by kilobytes.
+type RangeError struct {
+	Tag       string
+	Min       string
+	Max       string
+	Exclusive bool
+	Value     string
+}
+
+// Error describes the value and the range it is outside.
+func (e *RangeError) Error() string {
+	lower, upper := "[", "]"
+	if e.Exclusive {
+		lower, upper = "(", ")"
+	}
+	min, max := e.Min, e.Max
+	if min == "" {
+		lower, min = "(", "-inf"
+	}
+	if max == "" {
+		upper, max = ")", "+inf"
+	}
+	return "validator: " + e.Value + " is outside " + lower + min + ", " + max + upper
+}
+
+// LengthError details the failure of a length rule on a string, slice, array or map, such as max=20 or size=3. Its
+// bounds are inclusive, so gt=3 has a Min of 4, and Max is -1 when there is no maximum. Unit is the LengthMode of a
+// string, such as runes or bytes, or items. Rules comparing with another field, such as gt=Other, are bounded by the
+// length of that field.
+type LengthError struct {
+	Tag    string
+	Min    int
+	Max    int
+	Length int
+	Unit   string
+}
+
+// Error describes the length and the range it is outside.
+func (e *LengthError) Error() string {
+	max := "+inf)"
+	if e.Max >= 0 {
+		max = strconv.Itoa(e.Max) + "]"
+	}
+	return "validator: length of " + strconv.Itoa(e.Length) + " " + e.Unit + " is outside [" + strconv.Itoa(e.Min) + ", " + max
+}
+
+// ruleDetail returns the RangeError or LengthError detailing the failure of a length rule on the value, whose string
+// form is str, or nil for other rules and values.
+func ruleDetail(tag *ValidTag, value reflect.Value, str string) error {
+	if !lengthRules[tag.name] || len(tag.params) == 0 {
+		return nil
+	}
+	params := make([]string, len(tag.params))
+	mode := LengthRunes
+	for i, param := range tag.params {
+		bound, m, ok, err := splitLengthMode(param)
+		if err != nil {
+			return nil
+		}
+		if ok {
+			mode = m
+		}
+		params[i] = bound
+	}
+
+	var min, max string
+	exclusive := false
+	switch tag.name {
+	case "between":
+		if len(params) != 2 {
+			return nil
+		}
+		min, max = params[0], params[1]
+	case "min", "gte":
+		min = params[0]
+	case "gt":
+		min, exclusive = params[0], true
+	case "max", "lte":
+		max = params[0]
+	case "lt":
+		max, exclusive = params[0], true
+	case "size":
+		min, max = params[0], params[0]
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return newLengthError(tag.name, min, max, exclusive, StringLength(value.String(), mode), mode.String())
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return newLengthError(tag.name, min, max, exclusive, value.Len(), "items")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return &RangeError{Tag: tag.name, Min: min, Max: max, Exclusive: exclusive, Value: str}
+	}
+	if _, ok := bigNumber(value); ok {
+		return &RangeError{Tag: tag.name, Min: min, Max: max, Exclusive: exclusive, Value: str}
+	}
+	return nil
+}
+
+// comparisonTag returns a copy of the tag of a rule comparing with another field, such as gt=Other, whose param is the
+// value of the other field, or its length in the mode, for ruleDetail.
+func comparisonTag(tag *ValidTag, anotherField reflect.Value, mode LengthMode) *ValidTag {
+	var bound string
+	switch anotherField.Kind() {
+	case reflect.String:
+		bound = strconv.Itoa(StringLength(anotherField.String(), mode)) + ":" + mode.String()
+	case reflect.Slice, reflect.Array, reflect.Map:
+		bound = strconv.Itoa(anotherField.Len())
+	default:
+		bound = ToString(anotherField.Interface())
+	}
+	compared := *tag
+	compared.params = []string{bound}
+	return &compared
+}
+
+// newLengthError returns the LengthError of the bounds, made inclusive, or nil when they are not integers.
+func newLengthError(tag, min, max string, exclusive bool, length int, unit string) error {
+	e := &LengthError{Tag: tag, Max: -1, Length: length, Unit: unit}
+	if min != "" {
+		n, err := strconv.Atoi(min)
+		if err != nil {
+			return nil
+		}
+		if exclusive {
+			n++
+		}
+		e.Min = n
+	}
+	if max != "" {
+		n, err := strconv.Atoi(max)
+		if err != nil {
+			return nil
+		}
+		if exclusive {
+			n--
+		}
+		e.Max = n
+	}
+	return e
+}
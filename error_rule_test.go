@@ -0,0 +1,142 @@
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type ruleErrorUser struct {
+	Name  string   `valid:"required"`
+	Email string   `valid:"email"`
+	Age   int      `valid:"between=18|65"`
+	Score float64  `valid:"gt=0"`
+	Bio   string   `valid:"max=5:bytes"`
+	Nick  string   `valid:"gt=3"`
+	Tags  []string `valid:"size=2"`
+}
+
+func TestRuleErrorIs(t *testing.T) {
+	err := ValidateStruct(ruleErrorUser{Email: "a", Age: 70, Score: 1, Nick: "abcd", Tags: []string{"a", "b"}})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	for _, target := range []error{ErrRequired, ErrEmail, ErrBetween} {
+		if !errors.Is(err, target) {
+			t.Errorf("Expected errors.Is(err, %v) to be true", target)
+		}
+	}
+	for _, target := range []error{ErrMax, ErrGt, ErrSize, RuleError("slug"), errors.New("required")} {
+		if errors.Is(err, target) {
+			t.Errorf("Expected errors.Is(err, %v) to be false", target)
+		}
+	}
+
+	wrapped := fmt.Errorf("create user: %w", err)
+	if !errors.Is(wrapped, ErrEmail) {
+		t.Error("Expected errors.Is to see through wrapping")
+	}
+	if ErrRequired.Error() != "validator: required rule failed" {
+		t.Errorf("Unexpected message %q", ErrRequired.Error())
+	}
+	if unwrapped := err.(Errors).Unwrap(); len(unwrapped) != 3 {
+		t.Errorf("Expected Unwrap to return the 3 errors, got %v", unwrapped)
+	}
+
+	var fieldErr *FieldError
+	if !errors.As(wrapped, &fieldErr) || fieldErr.Tag != "required" {
+		t.Errorf("Expected errors.As to find the first FieldError, got %+v", fieldErr)
+	}
+}
+
+func TestRuleErrorDetails(t *testing.T) {
+	var tests = []struct {
+		param    ruleErrorUser
+		expected error
+		message  string
+	}{
+		{
+			ruleErrorUser{Name: "a", Age: 70, Score: 1, Nick: "abcd", Tags: []string{"a", "b"}},
+			&RangeError{Tag: "between", Min: "18", Max: "65", Value: "70"},
+			"validator: 70 is outside [18, 65]",
+		},
+		{
+			ruleErrorUser{Name: "a", Age: 20, Score: -1, Nick: "abcd", Tags: []string{"a", "b"}},
+			&RangeError{Tag: "gt", Min: "0", Exclusive: true, Value: "-1"},
+			"validator: -1 is outside (0, +inf)",
+		},
+		{
+			ruleErrorUser{Name: "a", Age: 20, Score: 1, Bio: "h\u00e9llo", Nick: "abcd", Tags: []string{"a", "b"}},
+			&LengthError{Tag: "max", Min: 0, Max: 5, Length: 6, Unit: "bytes"},
+			"validator: length of 6 bytes is outside [0, 5]",
+		},
+		{
+			ruleErrorUser{Name: "a", Age: 20, Score: 1, Nick: "abc", Tags: []string{"a", "b"}},
+			&LengthError{Tag: "gt", Min: 4, Max: -1, Length: 3, Unit: "runes"},
+			"validator: length of 3 runes is outside [4, +inf)",
+		},
+		{
+			ruleErrorUser{Name: "a", Age: 20, Score: 1, Nick: "abcd", Tags: []string{"a"}},
+			&LengthError{Tag: "size", Min: 2, Max: 2, Length: 1, Unit: "items"},
+			"validator: length of 1 items is outside [2, 2]",
+		},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		if err == nil {
+			t.Fatalf("Expected an error for %+v", test.param)
+		}
+		var actual error
+		switch test.expected.(type) {
+		case *RangeError:
+			var rangeErr *RangeError
+			if errors.As(err, &rangeErr) {
+				actual = rangeErr
+			}
+		case *LengthError:
+			var lengthErr *LengthError
+			if errors.As(err, &lengthErr) {
+				actual = lengthErr
+			}
+		}
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected %+v, got %+v", test.expected, actual)
+		} else if actual.Error() != test.message {
+			t.Errorf("Expected %q, got %q", test.message, actual.Error())
+		}
+	}
+
+	var rangeErr *RangeError
+	if errors.As(ValidateStruct(ruleErrorUser{Age: 20, Score: 1, Nick: "abcd", Tags: []string{"a", "b"}}), &rangeErr) {
+		t.Errorf("Expected no RangeError for a required error, got %+v", rangeErr)
+	}
+}
+
+func TestRuleErrorDetailsOfComparisons(t *testing.T) {
+	type Booking struct {
+		Guests    int `valid:"lte=MaxGuests"`
+		MaxGuests int
+		Code      string `valid:"gt=Prefix"`
+		Prefix    string
+		Upload    []byte `valid:"file,max=1"`
+	}
+
+	var rangeErr *RangeError
+	err := ValidateStruct(Booking{Guests: 5, MaxGuests: 4, Code: "abc", Prefix: "ab"})
+	if !errors.As(err, &rangeErr) || !reflect.DeepEqual(rangeErr, &RangeError{Tag: "lte", Max: "4", Value: "5"}) {
+		t.Errorf("Expected a RangeError for lte=MaxGuests, got %+v from %v", rangeErr, err)
+	}
+
+	var lengthErr *LengthError
+	err = ValidateStruct(Booking{Code: "ab", Prefix: "ab"})
+	if !errors.As(err, &lengthErr) || !reflect.DeepEqual(lengthErr, &LengthError{Tag: "gt", Min: 3, Max: -1, Length: 2, Unit: "runes"}) {
+		t.Errorf("Expected a LengthError for gt=Prefix, got %+v from %v", lengthErr, err)
+	}
+
+	rangeErr = nil
+	err = ValidateStruct(Booking{Code: "abc", Prefix: "ab", Upload: make([]byte, 2048)})
+	if !errors.As(err, &rangeErr) || !reflect.DeepEqual(rangeErr, &RangeError{Tag: "max", Max: "1", Value: "2"}) {
+		t.Errorf("Expected a RangeError in kilobytes for the file, got %+v from %v", rangeErr, err)
+	}
+}
@@ -3,6 +3,8 @@ package validator
 import (
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -205,3 +207,61 @@ func TestFieldErrorSetMessage(t *testing.T) {
 		t.Errorf("Expected 'New message', got %s", fe.Message)
 	}
 }
+
+type problemAddress struct {
+	City string `json:"city" valid:"required"`
+}
+
+type problemUser struct {
+	Name      string           `json:"name" valid:"between=3|10"`
+	Addresses []problemAddress `json:"addresses"`
+}
+
+func TestErrorsProblemDetails(t *testing.T) {
+	err := ValidateStruct(problemUser{Name: "a", Addresses: []problemAddress{{}}})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	problem := err.(Errors).ProblemDetails(ProblemOptions{Instance: "/users"})
+
+	data, _ := json.Marshal(problem)
+	expected := `{"type":"about:blank","title":"Unprocessable Entity","status":422,"detail":"2 parameters are invalid.","instance":"/users","invalid-params":[` +
+		`{"name":"name","reason":"The Name must be between 3 and 10 characters.","tag":"between","params":{"Max":"10","Min":"3"},"pointer":"/name"},` +
+		`{"name":"addresses.0.city","reason":"The City field is required.","tag":"required","pointer":"/addresses/0/city"}]}`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+
+	problem = Errors{errors.New("generic error")}.ProblemDetails(ProblemOptions{Type: "https://example.com/invalid", Status: http.StatusBadRequest, Detail: "Bad input."})
+	if problem.Type != "https://example.com/invalid" || problem.Title != "Bad Request" || problem.Status != 400 || problem.Detail != "Bad input." {
+		t.Errorf("Unexpected options %+v", problem)
+	}
+	if len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Reason != "generic error" || problem.InvalidParams[0].Pointer != "" {
+		t.Errorf("Unexpected invalid params %+v", problem.InvalidParams)
+	}
+}
+
+func TestErrorsWriteProblem(t *testing.T) {
+	translator := NewTranslator()
+	translator.SetMessage("zh_HK", Translate{"required": "{{.Attribute}} \u4e0d\u80fd\u70ba\u7a7a."})
+
+	errs := ValidateStruct(problemUser{Name: "abc", Addresses: []problemAddress{{}}}).(Errors)
+	recorder := httptest.NewRecorder()
+	if err := errs.WriteProblem(recorder, ProblemOptions{Translator: translator, Language: "zh-HK,en;q=0.5"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if recorder.Code != http.StatusUnprocessableEntity || recorder.Header().Get("Content-Type") != ProblemContentType || recorder.Header().Get("Content-Language") != "zh-HK" {
+		t.Errorf("Unexpected response %d %v", recorder.Code, recorder.Header())
+	}
+	var problem ProblemDetails
+	if err := json.Unmarshal(recorder.Body.Bytes(), &problem); err != nil {
+		t.Fatal(err)
+	}
+	if len(problem.InvalidParams) != 1 || problem.InvalidParams[0].Reason != "City \u4e0d\u80fd\u70ba\u7a7a." || problem.Detail != "1 parameter is invalid." {
+		t.Errorf("Expected a translated reason, got %+v", problem)
+	}
+	if errs[0].Error() != "The City field is required." {
+		t.Errorf("Expected the errors to be left untranslated, got %q", errs[0].Error())
+	}
+}
@@ -0,0 +1,529 @@
+// Command gendata refreshes the code tables embedded in the validator package from their upstream sources.
+//
+// Run it from the package directory with go generate, or directly:
+//
+//	go run ./internal/gendata -iban iban_registry.txt
+//
+// ISO 3166-1, ISO 639 and ISO 15924 come from the Debian iso-codes project and ISO 4217 from the list
+// published by SIX on behalf of ISO. The grapheme cluster and width properties of the length modes and the
+// decompositions of the nfc and nfkc modifiers come from the Unicode Character Database. The IBAN registry is only
+// distributed by SWIFT as a download behind a form, so its tab-separated text file must be passed with -iban;
+// without it data/iban.csv is kept.
+// Sources may be URLs or local paths.
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const header = "# Generated by go run ./internal/gendata. DO NOT EDIT.\n"
+
+func main() {
+	isoCodes := flag.String("iso-codes", "https://salsa.debian.org/iso-codes-team/iso-codes/-/raw/main/data", "directory or URL of the iso-codes JSON files")
+	iso4217 := flag.String("iso4217", "https://www.six-group.com/dam/download/financial-information/data-center/iso-currrency/lists/list-one.xml", "path or URL of the ISO 4217 list one XML")
+	iban := flag.String("iban", "", "path or URL of the SWIFT IBAN registry text file")
+	ucd := flag.String("ucd", "https://www.unicode.org/Public/16.0.0/ucd", "directory or URL of the Unicode Character Database")
+	out := flag.String("out", "data", "output directory")
+	flag.Parse()
+
+	if err := generateCountries(*isoCodes, *out); err != nil {
+		log.Fatal(err)
+	}
+	if err := generateLanguages(*isoCodes, *out); err != nil {
+		log.Fatal(err)
+	}
+	if err := generateScripts(*isoCodes, *out); err != nil {
+		log.Fatal(err)
+	}
+	if err := generateCurrencies(*iso4217, *out); err != nil {
+		log.Fatal(err)
+	}
+	if err := generateUnicode(*ucd, *out); err != nil {
+		log.Fatal(err)
+	}
+	if err := generateNormalization(*ucd, *out); err != nil {
+		log.Fatal(err)
+	}
+	if *iban != "" {
+		if err := generateIBAN(*iban, *out); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// open returns the content of a local path or an http(s) URL.
+func open(source string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return os.Open(source)
+	}
+	resp, err := http.Get(source)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%s: %s", source, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// join appends name to a directory path or URL.
+func join(base, name string) string {
+	return strings.TrimSuffix(base, "/") + "/" + name
+}
+
+// readISOCodes decodes an iso-codes JSON file, whose entries are under the standard number.
+func readISOCodes(base, name, key string) ([]map[string]string, error) {
+	r, err := open(join(base, name))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var data map[string][]map[string]string
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(data[key]) == 0 {
+		return nil, fmt.Errorf("%s: no entries under %q", name, key)
+	}
+	return data[key], nil
+}
+
+// writeCSV writes the records sorted by their first column after the header comment.
+func writeCSV(out, name string, columns []string, records [][]string) error {
+	sort.Slice(records, func(i, j int) bool { return records[i][0] < records[j][0] })
+
+	f, err := os.Create(filepath.Join(out, name))
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(f, header); err != nil {
+		f.Close()
+		return err
+	}
+	w := csv.NewWriter(f)
+	w.Write(columns)
+	w.WriteAll(records)
+	if err := w.Error(); err != nil {
+		f.Close()
+		return err
+	}
+	log.Printf("wrote %d records to %s", len(records), name)
+	return f.Close()
+}
+
+// writeList writes the sorted, deduplicated codes one per line after the header comment.
+func writeList(out, name string, codes []string) error {
+	sort.Strings(codes)
+	var b strings.Builder
+	b.WriteString(header)
+	n := 0
+	for i, code := range codes {
+		if i > 0 && code == codes[i-1] {
+			continue
+		}
+		b.WriteString(code)
+		b.WriteByte('\n')
+		n++
+	}
+	log.Printf("wrote %d codes to %s", n, name)
+	return os.WriteFile(filepath.Join(out, name), []byte(b.String()), 0o644)
+}
+
+func generateCountries(base, out string) error {
+	entries, err := readISOCodes(base, "iso_3166-1.json", "3166-1")
+	if err != nil {
+		return err
+	}
+	records := make([][]string, 0, len(entries))
+	for _, e := range entries {
+		records = append(records, []string{e["alpha_2"], e["alpha_3"], e["numeric"]})
+	}
+	return writeCSV(out, "iso3166-1.csv", []string{"alpha2", "alpha3", "numeric"}, records)
+}
+
+func generateLanguages(base, out string) error {
+	var codes []string
+	for _, source := range []struct{ name, key string }{
+		{"iso_639-2.json", "639-2"},
+		{"iso_639-3.json", "639-3"},
+		{"iso_639-5.json", "639-5"},
+	} {
+		entries, err := readISOCodes(base, source.name, source.key)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			for _, key := range []string{"alpha_2", "alpha_3", "bibliographic"} {
+				if code := e[key]; code != "" && !strings.Contains(code, "-") {
+					codes = append(codes, strings.ToLower(code))
+				}
+			}
+		}
+	}
+	return writeList(out, "iso639.txt", codes)
+}
+
+func generateScripts(base, out string) error {
+	entries, err := readISOCodes(base, "iso_15924.json", "15924")
+	if err != nil {
+		return err
+	}
+	codes := make([]string, 0, len(entries))
+	for _, e := range entries {
+		codes = append(codes, e["alpha_4"])
+	}
+	return writeList(out, "iso15924.txt", codes)
+}
+
+// currencyList is the ISO 4217 list one XML.
+type currencyList struct {
+	Entries []struct {
+		Code       string `xml:"Ccy"`
+		Number     string `xml:"CcyNbr"`
+		MinorUnits string `xml:"CcyMnrUnts"`
+	} `xml:"CcyTbl>CcyNtry"`
+}
+
+func generateCurrencies(source, out string) error {
+	r, err := open(source)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var list currencyList
+	if err := xml.NewDecoder(r).Decode(&list); err != nil {
+		return fmt.Errorf("iso4217: %w", err)
+	}
+
+	// A currency is listed once for every country using it; some countries have no currency.
+	seen := map[string]bool{}
+	var records [][]string
+	for _, e := range list.Entries {
+		if e.Code == "" || seen[e.Code] {
+			continue
+		}
+		seen[e.Code] = true
+		minorUnits := e.MinorUnits
+		if minorUnits == "N.A." {
+			minorUnits = ""
+		}
+		records = append(records, []string{e.Code, e.Number, minorUnits})
+	}
+	if len(records) == 0 {
+		return fmt.Errorf("iso4217: no currencies in %s", source)
+	}
+	return writeCSV(out, "iso4217.csv", []string{"alpha3", "numeric", "minor_units"}, records)
+}
+
+// generateIBAN reads the SWIFT IBAN registry text file, in which every country is a tab-separated column
+// and the rows are the data elements.
+func generateIBAN(source, out string) error {
+	r, err := open(source)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	reader := csv.NewReader(r)
+	reader.Comma = '\t'
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return fmt.Errorf("iban: %w", err)
+	}
+
+	var countries, lengths []string
+	for _, row := range rows {
+		if len(row) == 0 {
+			continue
+		}
+		label := strings.TrimSpace(row[0])
+		switch {
+		case strings.HasPrefix(label, "IBAN prefix country code"):
+			countries = row[1:]
+		case label == "IBAN length":
+			lengths = row[1:]
+		}
+	}
+	if len(countries) == 0 || len(countries) != len(lengths) {
+		return fmt.Errorf("iban: country and length rows not found in %s", source)
+	}
+
+	records := make([][]string, 0, len(countries))
+	for i, country := range countries {
+		country = strings.TrimSpace(country)
+		if len(country) != 2 {
+			continue
+		}
+		records = append(records, []string{country, strings.TrimSpace(lengths[i])})
+	}
+	return writeCSV(out, "iban.csv", []string{"country", "length"}, records)
+}
+
+// ucdRange is a range of code points with a property value of the Unicode Character Database.
+type ucdRange struct {
+	lo, hi   uint64
+	property string
+}
+
+// readUCD parses a semicolon-separated file of the Unicode Character Database, calling property with the fields
+// after the code points of every line; it returns the property to record, or "" to skip the line.
+func readUCD(base, name string, property func(fields []string) string) ([]ucdRange, error) {
+	r, err := open(join(base, name))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var ranges []ucdRange
+	for i, line := range strings.Split(string(data), "\n") {
+		if j := strings.IndexByte(line, '#'); j >= 0 {
+			line = line[:j]
+		}
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for k := range fields {
+			fields[k] = strings.TrimSpace(fields[k])
+		}
+		p := property(fields[1:])
+		if p == "" {
+			continue
+		}
+		lo, hi, _ := strings.Cut(fields[0], "..")
+		if hi == "" {
+			hi = lo
+		}
+		first, err := strconv.ParseUint(lo, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, i+1, err)
+		}
+		last, err := strconv.ParseUint(hi, 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", name, i+1, err)
+		}
+		ranges = append(ranges, ucdRange{first, last, p})
+	}
+	if len(ranges) == 0 {
+		return nil, fmt.Errorf("%s: no properties found", name)
+	}
+	return ranges, nil
+}
+
+// generateUnicode writes the Grapheme_Cluster_Break, Extended_Pictographic and Indic_Conjunct_Break properties
+// used to segment grapheme clusters, and the wide and fullwidth characters of East_Asian_Width as Wide.
+func generateUnicode(base, out string) error {
+	var ranges []ucdRange
+	for _, source := range []struct {
+		name     string
+		property func(fields []string) string
+	}{
+		{"auxiliary/GraphemeBreakProperty.txt", func(fields []string) string { return fields[0] }},
+		{"emoji/emoji-data.txt", func(fields []string) string {
+			if fields[0] == "Extended_Pictographic" {
+				return fields[0]
+			}
+			return ""
+		}},
+		{"DerivedCoreProperties.txt", func(fields []string) string {
+			if len(fields) == 2 && fields[0] == "InCB" {
+				return "InCB_" + fields[1]
+			}
+			return ""
+		}},
+		{"EastAsianWidth.txt", func(fields []string) string {
+			if fields[0] == "W" || fields[0] == "F" {
+				return "Wide"
+			}
+			return ""
+		}},
+	} {
+		r, err := readUCD(base, source.name, source.property)
+		if err != nil {
+			return err
+		}
+		ranges = append(ranges, r...)
+	}
+
+	// Merge adjacent ranges of the same property, which the UCD splits by general category.
+	sort.Slice(ranges, func(i, j int) bool {
+		if ranges[i].property != ranges[j].property {
+			return ranges[i].property < ranges[j].property
+		}
+		return ranges[i].lo < ranges[j].lo
+	})
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if r.property == last.property && r.lo <= last.hi+1 {
+			if r.hi > last.hi {
+				last.hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	var b strings.Builder
+	b.WriteString(header)
+	for _, r := range merged {
+		if r.lo == r.hi {
+			fmt.Fprintf(&b, "%04X;%s\n", r.lo, r.property)
+		} else {
+			fmt.Fprintf(&b, "%04X..%04X;%s\n", r.lo, r.hi, r.property)
+		}
+	}
+	log.Printf("wrote %d ranges to unicode.txt", len(merged))
+	return os.WriteFile(filepath.Join(out, "unicode.txt"), []byte(b.String()), 0o644)
+}
+
+// generateNormalization writes the canonical combining classes, the full canonical and compatibility decompositions
+// and the primary composites used by the nfc and nfkc modifiers. Hangul syllables are composed algorithmically and
+// are left out.
+func generateNormalization(base, out string) error {
+	records, err := readUCD(base, "UnicodeData.txt", func(fields []string) string {
+		if len(fields) < 5 || (fields[2] == "0" && fields[4] == "") {
+			return ""
+		}
+		return fields[2] + ";" + fields[4]
+	})
+	if err != nil {
+		return err
+	}
+	exclusions, err := readUCD(base, "DerivedNormalizationProps.txt", func(fields []string) string {
+		if fields[0] == "Full_Composition_Exclusion" {
+			return fields[0]
+		}
+		return ""
+	})
+	if err != nil {
+		return err
+	}
+
+	ccc := map[uint64]string{}
+	canonical := map[uint64][]uint64{}
+	compat := map[uint64][]uint64{}
+	for _, r := range records {
+		class, mapping, _ := strings.Cut(r.property, ";")
+		fields := strings.Fields(mapping)
+		isCompat := len(fields) > 0 && strings.HasPrefix(fields[0], "<")
+		if isCompat {
+			fields = fields[1:]
+		}
+		var runes []uint64
+		for _, f := range fields {
+			c, err := strconv.ParseUint(f, 16, 32)
+			if err != nil {
+				return fmt.Errorf("UnicodeData.txt: %04X: %w", r.lo, err)
+			}
+			runes = append(runes, c)
+		}
+		for c := r.lo; c <= r.hi; c++ {
+			if class != "0" {
+				ccc[c] = class
+			}
+			if len(runes) == 0 {
+				continue
+			}
+			if isCompat {
+				compat[c] = runes
+			} else {
+				canonical[c] = runes
+			}
+		}
+	}
+	excluded := map[uint64]bool{}
+	for _, r := range exclusions {
+		for c := r.lo; c <= r.hi; c++ {
+			excluded[c] = true
+		}
+	}
+
+	// The UCD maps each character one level down; the tables hold the full decompositions.
+	var decompose func(c uint64, compatibility bool) []uint64
+	decompose = func(c uint64, compatibility bool) []uint64 {
+		mapping, ok := canonical[c]
+		if !ok && compatibility {
+			mapping, ok = compat[c]
+		}
+		if !ok {
+			return []uint64{c}
+		}
+		var full []uint64
+		for _, m := range mapping {
+			full = append(full, decompose(m, compatibility)...)
+		}
+		return full
+	}
+	hex := func(runes []uint64) string {
+		s := make([]string, len(runes))
+		for i, c := range runes {
+			s[i] = fmt.Sprintf("%04X", c)
+		}
+		return strings.Join(s, " ")
+	}
+
+	var codes []uint64
+	seen := map[uint64]bool{}
+	for _, m := range []map[uint64][]uint64{canonical, compat} {
+		for c := range m {
+			if !seen[c] {
+				seen[c] = true
+				codes = append(codes, c)
+			}
+		}
+	}
+	for c := range ccc {
+		if !seen[c] {
+			seen[c] = true
+			codes = append(codes, c)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	var b strings.Builder
+	b.WriteString(header)
+	lines := 0
+	for _, c := range codes {
+		if class, ok := ccc[c]; ok {
+			fmt.Fprintf(&b, "%04X;ccc;%s\n", c, class)
+			lines++
+		}
+		nfd, nfkd := hex(decompose(c, false)), hex(decompose(c, true))
+		if mapping, ok := canonical[c]; ok {
+			fmt.Fprintf(&b, "%04X;nfd;%s\n", c, nfd)
+			lines++
+			if len(mapping) == 2 && !excluded[c] {
+				fmt.Fprintf(&b, "%04X;compose;%s\n", c, hex(mapping))
+				lines++
+			}
+		}
+		if nfkd != nfd {
+			fmt.Fprintf(&b, "%04X;nfkd;%s\n", c, nfkd)
+			lines++
+		}
+	}
+	log.Printf("wrote %d lines to normalization.txt", lines)
+	return os.WriteFile(filepath.Join(out, "normalization.txt"), []byte(b.String()), 0o644)
+}
@@ -13,21 +13,43 @@ var MessageMap = map[string]string{
 	"alphaDashUnicode":   "{{.Attribute}} 只能包含字母，数字，\"-\"，\"_\".",
 	"alphaNumUnicode":    "{{.Attribute}} 只能包含字母和数字.",
 	"array":              "{{.Attribute}} 必须是一个数组.",
+	"base64":             "{{.Attribute}} 必须是一个有效的 base64 字符串.",
+	"base64RawUrl":       "{{.Attribute}} 必须是一个有效的无填充 base64url 字符串.",
+	"base64url":          "{{.Attribute}} 必须是一个有效的 base64url 字符串.",
 	"before":             "{{.Attribute}} 必须是 {{.Date}} 之前的一个日期.",
 	"beforeOrEqual":      "{{.Attribute}} 必须是 {{.Date}} 之前或相同的一个日期.",
 	"between.numeric":    "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} 之间.",
 	"between.file":       "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} KB 之间.",
 	"between.string":     "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} 个字符之间.",
 	"between.array":      "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} 项之间.",
+	"between.bytes":      "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} 个字节之间.",
+	"between.width":      "{{.Attribute}} 宽度必须在 {{.Min}} 到 {{.Max}} 列之间.",
+	"bic":                "{{.Attribute}} 必须是一个有效的 BIC.",
 	"boolean":            "{{.Attribute}} 项必须是 true 或 false.",
+	"cidr":               "{{.Attribute}} 必须是一个有效的 CIDR 地址.",
+	"cidrv4":             "{{.Attribute}} 必须是一个有效的 IPv4 CIDR 地址.",
+	"cidrv6":             "{{.Attribute}} 必须是一个有效的 IPv6 CIDR 地址.",
+	"cnCreditCode":       "{{.Attribute}} 必须是一个有效的统一社会信用代码.",
+	"cnLandline":         "{{.Attribute}} 必须是一个有效的固定电话号码.",
+	"cnMobile":           "{{.Attribute}} 必须是一个有效的手机号码.",
+	"cnResidentId":       "{{.Attribute}} 必须是一个有效的居民身份证号码.",
+	"color":              "{{.Attribute}} 必须是一个有效的颜色.",
 	"confirmed":          "{{.Attribute}} 的确认不符合.",
+	"country2":           "{{.Attribute}} 必须是一个有效的 ISO 3166-1 二位字母国家代码.",
+	"country3":           "{{.Attribute}} 必须是一个有效的 ISO 3166-1 三位字母国家代码.",
+	"countryNumeric":     "{{.Attribute}} 必须是一个有效的 ISO 3166-1 数字国家代码.",
+	"creditCard":         "{{.Attribute}} 必须是一个有效的信用卡号码.",
+	"currency":           "{{.Attribute}} 必须是一个有效的 ISO 4217 货币代码.",
+	"currencyAmount":     "{{.Attribute}} 必须是一个有效的 {{.Currency}} 金额.",
 	"date":               "{{.Attribute}} 不是一个有效的日期.",
 	"dateFormat":         "{{.Attribute}} 与 {{.Format}} 不匹配.",
+	"decimal":            "{{.Attribute}} 最多只能有 {{.Precision}} 位数字及 {{.Scale}} 位小数.",
 	"different":          "{{.Attribute}} 和 {{.Other}} 必须不相同.",
 	"digits":             "{{.Attribute}} 必须是 {{.Digits}} 位数.",
 	"digitsBetween":      "{{.Attribute}} 必须在 {{.Min}} 到 {{.Max}} 位数之间.",
-	"dimensions":         "{{.Attribute}} 的图像尺寸无效.",
+	"dimensions":         "{{.Attribute}} 的图像尺寸 ({{.Width}}x{{.Height}}) 无效.",
 	"distinct":           "{{.Attribute}} 项有一个重复的值.",
+	"e164":               "{{.Attribute}} 必须是 E.164 格式的电话号码.",
 	"email":              "{{.Attribute}} 必须是一个合法的电子邮件地址.",
 	"exists":             "选定的 {{.Attribute}} 是无效的.",
 	"file":               "{{.Attribute}} 必须是一个档案.",
@@ -36,13 +58,19 @@ var MessageMap = map[string]string{
 	"gt.file":            "{{.Attribute}} 必须大於 {{.Value}} (千字节).",
 	"gt.string":          "{{.Attribute}} 必须大於 {{.Value}} 字符.",
 	"gt.array":           "{{.Attribute}} 必须大於 {{.Value}} 项.",
+	"gt.bytes":           "{{.Attribute}} 必须大於 {{.Value}} 字节.",
+	"gt.width":           "{{.Attribute}} 宽度必须大於 {{.Value}} 列.",
 	"gte.numeric":        "{{.Attribute}} 必须大於或等於 {{.Value}}.",
 	"gte.file":           "{{.Attribute}} 必须大於或等於 {{.Value}} (千字节).",
 	"gte.string":         "{{.Attribute}} 必须大於或等於 {{.Value}} 字符.",
 	"gte.array":          "{{.Attribute}} 至少有 {{.Value}} 项.",
+	"gte.bytes":          "{{.Attribute}} 必须大於或等於 {{.Value}} 字节.",
+	"gte.width":          "{{.Attribute}} 宽度必须大於或等於 {{.Value}} 列.",
 	"image":              "{{.Attribute}} 必须是一个图像.",
 	"in":                 "选定的 {{.Attribute}} 是无效的.",
 	"inArray":            "{{.Attribute}} 项不存在於 {{.Other}}.",
+	"notInArray":         "{{.Attribute}} 项不能存在於 {{.Other}}.",
+	"subsetOf":           "{{.Attribute}} 只能包含 {{.Other}} 中的值.",
 	"integer":            "{{.Attribute}} 必须是一个整数.",
 	"ip":                 "{{.Attribute}} 必须是一个有效的 IP 地址.",
 	"ipv4":               "{{.Attribute}} 必须是一个有效的 IPv4 地址.",
@@ -52,26 +80,64 @@ var MessageMap = map[string]string{
 	"lt.file":            "{{.Attribute}} 必须少於 {{.Value}} (千字节).",
 	"lt.string":          "{{.Attribute}} 必须少於 {{.Value}} 字符.",
 	"lt.array":           "{{.Attribute}} 必须少於 {{.Value}} 项.",
+	"lt.bytes":           "{{.Attribute}} 必须少於 {{.Value}} 字节.",
+	"lt.width":           "{{.Attribute}} 宽度必须少於 {{.Value}} 列.",
 	"lte.numeric":        "{{.Attribute}} 必须少於或等於 {{.Value}}.",
 	"lte.file":           "{{.Attribute}} 必须少於或等於 {{.Value}} (千字节).",
 	"lte.string":         "{{.Attribute}} 必须少於或等於 {{.Value}} 字符.",
 	"lte.array":          "{{.Attribute}} 不能大於 {{.Value}} 项.",
+	"lte.bytes":          "{{.Attribute}} 必须少於或等於 {{.Value}} 字节.",
+	"lte.width":          "{{.Attribute}} 宽度必须少於或等於 {{.Value}} 列.",
 	"max.numeric":        "{{.Attribute}} 不能大於 {{.Max}}.",
 	"max.file":           "{{.Attribute}} 不能大於 {{.Max}} (千字节).",
 	"max.string":         "{{.Attribute}} 不能大於 {{.Max}} 字符..",
 	"max.array":          "{{.Attribute}} 不能大於 {{.Max}} 项.",
+	"max.bytes":          "{{.Attribute}} 不能大於 {{.Max}} 字节.",
+	"max.width":          "{{.Attribute}} 宽度不能大於 {{.Max}} 列.",
 	"mimes":              "{{.Attribute}} 必须是 {{.Values}} 的档案类型.",
 	"mimetypes":          "{{.Attribute}} 必须是 {{.Values}} 的档案类型.",
 	"min.numeric":        "{{.Attribute}} 的最小长度为 {{.Min}} 位.",
 	"min.file":           "{{.Attribute}} 大小至少为 {{.Min}} KB.",
 	"min.string":         "{{.Attribute}} 的最小长度为 {{.Min}} 字符.",
 	"min.array":          "{{.Attribute}} 至少有 {{.Min}} 项.",
+	"min.bytes":          "{{.Attribute}} 的最小长度为 {{.Min}} 字节.",
+	"min.width":          "{{.Attribute}} 的最小宽度为 {{.Min}} 列.",
 	"notIn":              "选定的 {{.Attribute}} 是无效的.",
 	"notRegex":           "无效的 {{.Attribute}} 格式.",
 	"numeric":            "{{.Attribute}} 必须是一个数字.",
 	"int":                "{{.Attribute}} 必须是一个整数.",
 	"float":              "{{.Attribute}} 必须是一个浮点数.",
+	"fqdn":               "{{.Attribute}} 必须是一个完整的域名.",
+	"hexadecimal":        "{{.Attribute}} 必须是十六进制数.",
+	"hexColor":           "{{.Attribute}} 必须是一个有效的十六进制颜色.",
+	"hkid":               "{{.Attribute}} 必须是一个有效的香港身份证号码.",
+	"hostname":           "{{.Attribute}} 必须是一个有效的主机名.",
+	"hostPort":           "{{.Attribute}} 必须是一个有效的主机和端口.",
+	"hsl":                "{{.Attribute}} 必须是一个有效的 HSL 颜色.",
+	"hsla":               "{{.Attribute}} 必须是一个有效的 HSLA 颜色.",
+	"httpUrl":            "{{.Attribute}} 必须是一个有效的 HTTP 或 HTTPS 网址.",
+	"iban":               "{{.Attribute}} 必须是一个有效的 IBAN.",
+	"ipIn":               "{{.Attribute}} 必须是 {{.Values}} 中的 IP 地址.",
+	"ipNotIn":            "{{.Attribute}} 必须是不在 {{.Values}} 中的 IP 地址.",
+	"isbn":               "{{.Attribute}} 必须是一个有效的 ISBN.",
+	"isbn10":             "{{.Attribute}} 必须是一个有效的 ISBN-10.",
+	"isbn13":             "{{.Attribute}} 必须是一个有效的 ISBN-13.",
+	"jwt":                "{{.Attribute}} 必须是一个有效的 JSON Web Token.",
+	"language":           "{{.Attribute}} 必须是一个有效的语言标签.",
+	"loopback":           "{{.Attribute}} 必须是一个回环 IP 地址.",
+	"mac":                "{{.Attribute}} 必须是一个有效的 MAC 地址.",
+	"multipleOf":         "{{.Attribute}} 必须是 {{.Value}} 的倍数.",
+	"negative":           "{{.Attribute}} 必须是负数.",
+	"nonZero":            "{{.Attribute}} 不能为零.",
+	"password":           "{{.Attribute}} 强度不足.",
+	"phone":              "{{.Attribute}} 必须是一个有效的电话号码.",
+	"port":               "{{.Attribute}} 必须是一个有效的端口号.",
+	"positive":           "{{.Attribute}} 必须是正数.",
+	"postcode":           "{{.Attribute}} 必须是一个有效的邮政编码.",
+	"postcodeField":      "{{.Attribute}} 必须是一个有效的邮政编码.",
 	"present":            "{{.Attribute}} 必须存在.",
+	"privateIp":          "{{.Attribute}} 必须是一个私有 IP 地址.",
+	"publicIp":           "{{.Attribute}} 必须是一个公网 IP 地址.",
 	"regex":              "无效的 {{.Attribute}} 格式.",
 	"required":           "{{.Attribute}} 字段是必须的.",
 	"requiredIf":         "{{.Attribute}} 字段是必须的当 {{.Other}} 是 {{.Value}}.",
@@ -80,18 +146,33 @@ var MessageMap = map[string]string{
 	"requiredWithAll":    "当 {{.Values}} 存在时， {{.Attribute}} 必须输入.",
 	"requiredWithout":    "当 {{.Values}} 不存在时， {{.Attribute}} 必须输入.",
 	"requiredWithoutAll": "当 {{.Values}} 不存在时， {{.Attribute}} 必须输入.",
+	"rgb":                "{{.Attribute}} 必须是一个有效的 RGB 颜色.",
+	"rgba":               "{{.Attribute}} 必须是一个有效的 RGBA 颜色.",
+	"safeUrl":            "{{.Attribute}} 必须是指向公网主机的网址.",
 	"same":               "{{.Attribute}} 和 {{.Other}} 必须匹配.",
+	"semver":             "{{.Attribute}} 必须是一个有效的语义化版本号.",
+	"semverRange":        "{{.Attribute}} 必须是满足 {{.Values}} 的版本号.",
 	"size.numeric":       "{{.Attribute}} 必须是 {{.Size}}.",
 	"size.file":          "{{.Attribute}} 必须是 {{.Size}} (千字节).",
 	"size.string":        "{{.Attribute}} 必须是 {{.Size}} 字符.",
 	"size.array":         "{{.Attribute}} 必须包含 {{.Size}}.",
+	"size.bytes":         "{{.Attribute}} 必须是 {{.Size}} 字节.",
+	"size.width":         "{{.Attribute}} 宽度必须是 {{.Size}} 列.",
 	"string":             "{{.Attribute}} 必须是一串字符.",
 	"timezone":           "{{.Attribute}} 必须是一个有效的区域.",
+	"ulid":               "{{.Attribute}} 必须是一个有效的 ULID.",
 	"unique":             "{{.Attribute}} 已经被采取.",
+	"unixAddr":           "{{.Attribute}} 必须是一个有效的 unix 套接字地址.",
 	"uploaded":           "{{.Attribute}} 无法上传.",
 	"url":                "{{.Attribute}} 格式无效.",
+	"urlHost":            "{{.Attribute}} 必须是以下主机的网址: {{.Values}}.",
+	"urlRequireScheme":   "{{.Attribute}} 必须是包含协议和主机的完整网址.",
 	"uuid3":              "{{.Attribute}} 格式无效.",
 	"uuid4":              "{{.Attribute}} 格式无效.",
 	"uuid5":              "{{.Attribute}} 格式无效.",
 	"uuid":               "{{.Attribute}} 格式无效.",
+	"uuid1":              "{{.Attribute}} 格式无效.",
+	"uuid6":              "{{.Attribute}} 格式无效.",
+	"uuid7":              "{{.Attribute}} 格式无效.",
+	"uuidAny":            "{{.Attribute}} 格式无效.",
 }
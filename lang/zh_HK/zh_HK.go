@@ -13,21 +13,43 @@ var MessageMap = map[string]string{
 	"alphaDashUnicode":   "{{.Attribute}} 只能包含字母，數字，\"-\"，\"_\".",
 	"alphaNumUnicode":    "{{.Attribute}} 只能包含字母和數字.",
 	"array":              "{{.Attribute}} 必須是一個數組.",
+	"base64":             "{{.Attribute}} 必須是一個有效的 base64 字串.",
+	"base64RawUrl":       "{{.Attribute}} 必須是一個有效的無填充 base64url 字串.",
+	"base64url":          "{{.Attribute}} 必須是一個有效的 base64url 字串.",
 	"before":             "{{.Attribute}} 必須是 {{.Date}} 之前的一個日期.",
 	"beforeOrEqual":      "{{.Attribute}} 必須是 {{.Date}} 之前或相同的一個日期.",
 	"between.numeric":    "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} 之間.",
 	"between.file":       "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} KB 之間.",
 	"between.string":     "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} 個字符之間.",
 	"between.array":      "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} 項之間.",
+	"between.bytes":      "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} 個字節之間.",
+	"between.width":      "{{.Attribute}} 寬度必須在 {{.Min}} 到 {{.Max}} 列之間.",
+	"bic":                "{{.Attribute}} 必須是一個有效的 BIC.",
 	"boolean":            "{{.Attribute}} 項必須是 true 或 false.",
+	"cidr":               "{{.Attribute}} 必須是一個有效的 CIDR 地址.",
+	"cidrv4":             "{{.Attribute}} 必須是一個有效的 IPv4 CIDR 地址.",
+	"cidrv6":             "{{.Attribute}} 必須是一個有效的 IPv6 CIDR 地址.",
+	"cnCreditCode":       "{{.Attribute}} 必須是一個有效的統一社會信用代碼.",
+	"cnLandline":         "{{.Attribute}} 必須是一個有效的固網電話號碼.",
+	"cnMobile":           "{{.Attribute}} 必須是一個有效的手提電話號碼.",
+	"cnResidentId":       "{{.Attribute}} 必須是一個有效的居民身份證號碼.",
+	"color":              "{{.Attribute}} 必須是一個有效的顏色.",
 	"confirmed":          "{{.Attribute}} 的確認不符合.",
+	"country2":           "{{.Attribute}} 必須是一個有效的 ISO 3166-1 二位字母國家代碼.",
+	"country3":           "{{.Attribute}} 必須是一個有效的 ISO 3166-1 三位字母國家代碼.",
+	"countryNumeric":     "{{.Attribute}} 必須是一個有效的 ISO 3166-1 數字國家代碼.",
+	"creditCard":         "{{.Attribute}} 必須是一個有效的信用卡號碼.",
+	"currency":           "{{.Attribute}} 必須是一個有效的 ISO 4217 貨幣代碼.",
+	"currencyAmount":     "{{.Attribute}} 必須是一個有效的 {{.Currency}} 金額.",
 	"date":               "{{.Attribute}} 不是一個有效的日期.",
 	"dateFormat":         "{{.Attribute}} 與 {{.Format}} 不匹配.",
+	"decimal":            "{{.Attribute}} 最多只能有 {{.Precision}} 位數字及 {{.Scale}} 位小數.",
 	"different":          "{{.Attribute}} 和 {{.Other}} 必須不相同.",
 	"digits":             "{{.Attribute}} 必須是 {{.Digits}} 位數.",
 	"digitsBetween":      "{{.Attribute}} 必須在 {{.Min}} 到 {{.Max}} 位數之間.",
-	"dimensions":         "{{.Attribute}} 的圖像尺寸無效.",
+	"dimensions":         "{{.Attribute}} 的圖像尺寸 ({{.Width}}x{{.Height}}) 無效.",
 	"distinct":           "{{.Attribute}} 項有一個重復的值.",
+	"e164":               "{{.Attribute}} 必須是 E.164 格式的電話號碼.",
 	"email":              "{{.Attribute}} 必須是一個合法的電子郵件地址.",
 	"exists":             "選定的 {{.Attribute}} 是無效的.",
 	"file":               "{{.Attribute}} 必須是一個檔案.",
@@ -36,13 +58,19 @@ var MessageMap = map[string]string{
 	"gt.file":            "{{.Attribute}} 必須大於 {{.Value}} (千字節).",
 	"gt.string":          "{{.Attribute}} 必須大於 {{.Value}} 字符.",
 	"gt.array":           "{{.Attribute}} 必須大於 {{.Value}} 項.",
+	"gt.bytes":           "{{.Attribute}} 必須大於 {{.Value}} 字節.",
+	"gt.width":           "{{.Attribute}} 寬度必須大於 {{.Value}} 列.",
 	"gte.numeric":        "{{.Attribute}} 必須大於或等於 {{.Value}}.",
 	"gte.file":           "{{.Attribute}} 必須大於或等於 {{.Value}} (千字節).",
 	"gte.string":         "{{.Attribute}} 必須大於或等於 {{.Value}} 字符.",
 	"gte.array":          "{{.Attribute}} 至少有 {{.Value}} 項.",
+	"gte.bytes":          "{{.Attribute}} 必須大於或等於 {{.Value}} 字節.",
+	"gte.width":          "{{.Attribute}} 寬度必須大於或等於 {{.Value}} 列.",
 	"image":              "{{.Attribute}} 必須是一個圖像.",
 	"in":                 "選定的 {{.Attribute}} 是無效的.",
 	"inArray":            "{{.Attribute}} 項不存在於 {{.Other}}.",
+	"notInArray":         "{{.Attribute}} 項不能存在於 {{.Other}}.",
+	"subsetOf":           "{{.Attribute}} 只能包含 {{.Other}} 中的值.",
 	"integer":            "{{.Attribute}} 必須是一個整數.",
 	"ip":                 "{{.Attribute}} 必須是一個有效的 IP 地址.",
 	"ipv4":               "{{.Attribute}} 必須是一個有效的 IPv4 地址.",
@@ -52,26 +80,64 @@ var MessageMap = map[string]string{
 	"lt.file":            "{{.Attribute}} 必須少於 {{.Value}} (千字節).",
 	"lt.string":          "{{.Attribute}} 必須少於 {{.Value}} 字符.",
 	"lt.array":           "{{.Attribute}} 必須少於 {{.Value}} 項.",
+	"lt.bytes":           "{{.Attribute}} 必須少於 {{.Value}} 字節.",
+	"lt.width":           "{{.Attribute}} 寬度必須少於 {{.Value}} 列.",
 	"lte.numeric":        "{{.Attribute}} 必須少於或等於 {{.Value}}.",
 	"lte.file":           "{{.Attribute}} 必須少於或等於 {{.Value}} (千字節).",
 	"lte.string":         "{{.Attribute}} 必須少於或等於 {{.Value}} 字符.",
 	"lte.array":          "{{.Attribute}} 不能大於 {{.Value}} 項.",
+	"lte.bytes":          "{{.Attribute}} 必須少於或等於 {{.Value}} 字節.",
+	"lte.width":          "{{.Attribute}} 寬度必須少於或等於 {{.Value}} 列.",
 	"max.numeric":        "{{.Attribute}} 不能大於 {{.Max}}.",
 	"max.file":           "{{.Attribute}} 不能大於 {{.Max}} (千字節).",
 	"max.string":         "{{.Attribute}} 不能大於 {{.Max}} 字符..",
 	"max.array":          "{{.Attribute}} 不能大於 {{.Max}} 項.",
+	"max.bytes":          "{{.Attribute}} 不能大於 {{.Max}} 字節.",
+	"max.width":          "{{.Attribute}} 寬度不能大於 {{.Max}} 列.",
 	"mimes":              "{{.Attribute}} 必須是 {{.Values}} 的檔案類型.",
 	"mimetypes":          "{{.Attribute}} 必須是 {{.Values}} 的檔案類型.",
 	"min.numeric":        "{{.Attribute}} 的最小長度為 {{.Min}} 位.",
 	"min.file":           "{{.Attribute}} 大小至少為 {{.Min}} KB.",
 	"min.string":         "{{.Attribute}} 的最小長度為 {{.Min}} 字符.",
 	"min.array":          "{{.Attribute}} 至少有 {{.Min}} 項.",
+	"min.bytes":          "{{.Attribute}} 的最小長度為 {{.Min}} 字節.",
+	"min.width":          "{{.Attribute}} 的最小寬度為 {{.Min}} 列.",
 	"notIn":              "選定的 {{.Attribute}} 是無效的.",
 	"notRegex":           "無效的 {{.Attribute}} 格式.",
 	"numeric":            "{{.Attribute}} 必須是一個數字.",
 	"int":                "{{.Attribute}} 必須是一個整數.",
 	"float":              "{{.Attribute}} 必须是一個浮點數.",
+	"fqdn":               "{{.Attribute}} 必須是一個完整的域名.",
+	"hexadecimal":        "{{.Attribute}} 必須是十六進制數.",
+	"hexColor":           "{{.Attribute}} 必須是一個有效的十六進制顏色.",
+	"hkid":               "{{.Attribute}} 必須是一個有效的香港身份證號碼.",
+	"hostname":           "{{.Attribute}} 必須是一個有效的主機名.",
+	"hostPort":           "{{.Attribute}} 必須是一個有效的主機和端口.",
+	"hsl":                "{{.Attribute}} 必須是一個有效的 HSL 顏色.",
+	"hsla":               "{{.Attribute}} 必須是一個有效的 HSLA 顏色.",
+	"httpUrl":            "{{.Attribute}} 必須是一個有效的 HTTP 或 HTTPS 網址.",
+	"iban":               "{{.Attribute}} 必須是一個有效的 IBAN.",
+	"ipIn":               "{{.Attribute}} 必須是 {{.Values}} 中的 IP 地址.",
+	"ipNotIn":            "{{.Attribute}} 必須是不在 {{.Values}} 中的 IP 地址.",
+	"isbn":               "{{.Attribute}} 必須是一個有效的 ISBN.",
+	"isbn10":             "{{.Attribute}} 必須是一個有效的 ISBN-10.",
+	"isbn13":             "{{.Attribute}} 必須是一個有效的 ISBN-13.",
+	"jwt":                "{{.Attribute}} 必須是一個有效的 JSON Web Token.",
+	"language":           "{{.Attribute}} 必須是一個有效的語言標籤.",
+	"loopback":           "{{.Attribute}} 必須是一個回環 IP 地址.",
+	"mac":                "{{.Attribute}} 必須是一個有效的 MAC 地址.",
+	"multipleOf":         "{{.Attribute}} 必須是 {{.Value}} 的倍數.",
+	"negative":           "{{.Attribute}} 必須是負數.",
+	"nonZero":            "{{.Attribute}} 不能為零.",
+	"password":           "{{.Attribute}} 強度不足.",
+	"phone":              "{{.Attribute}} 必須是一個有效的電話號碼.",
+	"port":               "{{.Attribute}} 必須是一個有效的端口號.",
+	"positive":           "{{.Attribute}} 必須是正數.",
+	"postcode":           "{{.Attribute}} 必須是一個有效的郵政編碼.",
+	"postcodeField":      "{{.Attribute}} 必須是一個有效的郵政編碼.",
 	"present":            "{{.Attribute}} 必須存在.",
+	"privateIp":          "{{.Attribute}} 必須是一個私有 IP 地址.",
+	"publicIp":           "{{.Attribute}} 必須是一個公網 IP 地址.",
 	"regex":              "無效的 {{.Attribute}} 格式.",
 	"required":           "{{.Attribute}} 字段是必須的.",
 	"requiredIf":         "{{.Attribute}} 字段是必須的當 {{.Other}} 是 {{.Value}}.",
@@ -80,18 +146,33 @@ var MessageMap = map[string]string{
 	"requiredWithAll":    "當 {{.Values}} 存在時， {{.Attribute}} 必須輸入.",
 	"requiredWithout":    "當 {{.Values}} 不存在時， {{.Attribute}} 必須輸入.",
 	"requiredWithoutAll": "當 {{.Values}} 不存在時， {{.Attribute}} 必須輸入.",
+	"rgb":                "{{.Attribute}} 必須是一個有效的 RGB 顏色.",
+	"rgba":               "{{.Attribute}} 必須是一個有效的 RGBA 顏色.",
+	"safeUrl":            "{{.Attribute}} 必須是指向公網主機的網址.",
 	"same":               "{{.Attribute}} 和 {{.Other}} 必須匹配.",
+	"semver":             "{{.Attribute}} 必須是一個有效的語義化版本號.",
+	"semverRange":        "{{.Attribute}} 必須是滿足 {{.Values}} 的版本號.",
 	"size.numeric":       "{{.Attribute}} 必須是 {{.Size}}.",
 	"size.file":          "{{.Attribute}} 必須是 {{.Size}} (千字節).",
 	"size.string":        "{{.Attribute}} 必須是 {{.Size}} 字符.",
 	"size.array":         "{{.Attribute}} 必須包含 {{.Size}}.",
+	"size.bytes":         "{{.Attribute}} 必須是 {{.Size}} 字節.",
+	"size.width":         "{{.Attribute}} 寬度必須是 {{.Size}} 列.",
 	"string":             "{{.Attribute}} 必須是一串字符.",
 	"timezone":           "{{.Attribute}} 必須是一個有效的區域.",
+	"ulid":               "{{.Attribute}} 必須是一個有效的 ULID.",
 	"unique":             "{{.Attribute}} 已經被采取.",
+	"unixAddr":           "{{.Attribute}} 必須是一個有效的 unix 套接字地址.",
 	"uploaded":           "{{.Attribute}} 無法上傳.",
 	"url":                "{{.Attribute}} 格式無效.",
+	"urlHost":            "{{.Attribute}} 必須是以下主機的網址: {{.Values}}.",
+	"urlRequireScheme":   "{{.Attribute}} 必須是包含協議和主機的完整網址.",
 	"uuid3":              "{{.Attribute}} 格式无效.",
 	"uuid4":              "{{.Attribute}} 格式无效.",
 	"uuid5":              "{{.Attribute}} 格式无效.",
 	"uuid":               "{{.Attribute}} 格式无效.",
+	"uuid1":              "{{.Attribute}} 格式無效.",
+	"uuid6":              "{{.Attribute}} 格式無效.",
+	"uuid7":              "{{.Attribute}} 格式無效.",
+	"uuidAny":            "{{.Attribute}} 格式無效.",
 }
@@ -10,63 +10,129 @@ var MessageMap = map[string]string{
 	"alphaDash":          "The {{.Attribute}} may only contain letters, numbers, dashes and underscores.",
 	"alphaNum":           "The {{.Attribute}} may only contain letters and numbers.",
 	"array":              "The {{.Attribute}} must be an array.",
+	"base64":             "The {{.Attribute}} must be a valid base64 string.",
+	"base64RawUrl":       "The {{.Attribute}} must be a valid unpadded base64url string.",
+	"base64url":          "The {{.Attribute}} must be a valid base64url string.",
 	"before":             "The {{.Attribute}} must be a date before {{.Date}}.",
 	"beforeOrEqual":      "The {{.Attribute}} must be a date before or equal to {{.Date}}.",
 	"between.numeric":    "The {{.Attribute}} must be between {{.Min}} and {{.Max}}.",
 	"between.file":       "The {{.Attribute}} must be between {{.Min}} and {{.Max}} kilobytes.",
 	"between.string":     "The {{.Attribute}} must be between {{.Min}} and {{.Max}} characters.",
 	"between.array":      "The {{.Attribute}} must have between {{.Min}} and {{.Max}} items.",
+	"between.bytes":      "The {{.Attribute}} must be between {{.Min}} and {{.Max}} bytes.",
+	"between.width":      "The {{.Attribute}} must be between {{.Min}} and {{.Max}} columns wide.",
+	"bic":                "The {{.Attribute}} must be a valid BIC.",
 	"boolean":            "The {{.Attribute}} field must be true or false.",
+	"cidr":               "The {{.Attribute}} must be a valid CIDR notation.",
+	"cidrv4":             "The {{.Attribute}} must be a valid IPv4 CIDR notation.",
+	"cidrv6":             "The {{.Attribute}} must be a valid IPv6 CIDR notation.",
+	"cnCreditCode":       "The {{.Attribute}} must be a valid unified social credit code.",
+	"cnLandline":         "The {{.Attribute}} must be a valid landline phone number.",
+	"cnMobile":           "The {{.Attribute}} must be a valid mobile phone number.",
+	"cnResidentId":       "The {{.Attribute}} must be a valid resident identity card number.",
+	"color":              "The {{.Attribute}} must be a valid color.",
 	"confirmed":          "The {{.Attribute}} confirmation does not match.",
+	"country2":           "The {{.Attribute}} must be a valid ISO 3166-1 alpha-2 country code.",
+	"country3":           "The {{.Attribute}} must be a valid ISO 3166-1 alpha-3 country code.",
+	"countryNumeric":     "The {{.Attribute}} must be a valid ISO 3166-1 numeric country code.",
+	"creditCard":         "The {{.Attribute}} must be a valid credit card number.",
+	"currency":           "The {{.Attribute}} must be a valid ISO 4217 currency code.",
+	"currencyAmount":     "The {{.Attribute}} must be a valid amount in {{.Currency}}.",
 	"date":               "The {{.Attribute}} is not a valid date.",
 	"dateFormat":         "The {{.Attribute}} does not match the format {{.Format}}.",
+	"decimal":            "The {{.Attribute}} must have at most {{.Precision}} digits and {{.Scale}} decimal places.",
 	"different":          "The {{.Attribute}} and {{.Other}} must be different.",
 	"digits":             "The {{.Attribute}} must be {{.Digits}} digits.",
 	"digitsBetween":      "The {{.Attribute}} must be between {{.Min}} and {{.Max}} digits.",
-	"dimensions":         "The {{.Attribute}} has invalid image dimensions.",
+	"dimensions":         "The {{.Attribute}} has invalid image dimensions ({{.Width}}x{{.Height}}).",
 	"distinct":           "The {{.Attribute}} field has a duplicate value.",
+	"e164":               "The {{.Attribute}} must be a phone number in E.164 format.",
 	"email":              "The {{.Attribute}} must be a valid email address.",
 	"exists":             "The selected {{.Attribute}} is invalid.",
 	"file":               "The {{.Attribute}} must be a file.",
 	"filled":             "The {{.Attribute}} field must have a value.",
+	"fqdn":               "The {{.Attribute}} must be a fully qualified domain name.",
 	"gt.numeric":         "The {{.Attribute}} must be greater than {{.Value}}.",
 	"gt.file":            "The {{.Attribute}} must be greater than {{.Value}} kilobytes.",
 	"gt.string":          "The {{.Attribute}} must be greater than {{.Value}} characters.",
 	"gt.array":           "The {{.Attribute}} must have greater than {{.Value}} items.",
+	"gt.bytes":           "The {{.Attribute}} must be greater than {{.Value}} bytes.",
+	"gt.width":           "The {{.Attribute}} must be wider than {{.Value}} columns.",
 	"gte.numeric":        "The {{.Attribute}} must be greater than or equal {{.Value}}.",
 	"gte.file":           "The {{.Attribute}} must be greater than or equal {{.Value}} kilobytes.",
 	"gte.string":         "The {{.Attribute}} must be greater than or equal {{.Value}} characters.",
 	"gte.array":          "The {{.Attribute}} must have {{.Value}} items or more.",
+	"gte.bytes":          "The {{.Attribute}} must be greater than or equal {{.Value}} bytes.",
+	"gte.width":          "The {{.Attribute}} must be at least {{.Value}} columns wide.",
+	"hexadecimal":        "The {{.Attribute}} must be a hexadecimal number.",
+	"hexColor":           "The {{.Attribute}} must be a valid hexadecimal color.",
+	"hkid":               "The {{.Attribute}} must be a valid Hong Kong identity card number.",
+	"hostname":           "The {{.Attribute}} must be a valid hostname.",
+	"hostPort":           "The {{.Attribute}} must be a valid host and port.",
+	"hsl":                "The {{.Attribute}} must be a valid HSL color.",
+	"hsla":               "The {{.Attribute}} must be a valid HSLA color.",
+	"httpUrl":            "The {{.Attribute}} must be a valid HTTP or HTTPS URL.",
+	"iban":               "The {{.Attribute}} must be a valid IBAN.",
 	"image":              "The {{.Attribute}} must be an image.",
 	"in":                 "The selected {{.Attribute}} is invalid.",
 	"inArray":            "The {{.Attribute}} field does not exist in {{.Other}}.",
+	"notInArray":         "The {{.Attribute}} field must not exist in {{.Other}}.",
+	"subsetOf":           "The {{.Attribute}} field must only contain values from {{.Other}}.",
 	"integer":            "The {{.Attribute}} must be an integer.",
 	"ip":                 "The {{.Attribute}} must be a valid IP address.",
+	"ipIn":               "The {{.Attribute}} must be an IP address in: {{.Values}}.",
+	"ipNotIn":            "The {{.Attribute}} must be an IP address not in: {{.Values}}.",
 	"ipv4":               "The {{.Attribute}} must be a valid IPv4 address.",
 	"ipv6":               "The {{.Attribute}} must be a valid IPv6 address.",
+	"isbn":               "The {{.Attribute}} must be a valid ISBN.",
+	"isbn10":             "The {{.Attribute}} must be a valid ISBN-10.",
+	"isbn13":             "The {{.Attribute}} must be a valid ISBN-13.",
 	"json":               "The {{.Attribute}} must be a valid JSON string.",
+	"jwt":                "The {{.Attribute}} must be a valid JSON Web Token.",
+	"language":           "The {{.Attribute}} must be a valid language tag.",
+	"loopback":           "The {{.Attribute}} must be a loopback IP address.",
 	"lt.numeric":         "The {{.Attribute}} must be less than {{.Value}}.",
 	"lt.file":            "The {{.Attribute}} must be less than {{.Value}} kilobytes.",
 	"lt.string":          "The {{.Attribute}} must be less than {{.Value}} characters.",
 	"lt.array":           "The {{.Attribute}} must have less than {{.Value}} items.",
+	"lt.bytes":           "The {{.Attribute}} must be less than {{.Value}} bytes.",
+	"lt.width":           "The {{.Attribute}} must be narrower than {{.Value}} columns.",
 	"lte.numeric":        "The {{.Attribute}} must be less than or equal {{.Value}}.",
 	"lte.file":           "The {{.Attribute}} must be less than or equal {{.Value}} kilobytes.",
 	"lte.string":         "The {{.Attribute}} must be less than or equal {{.Value}} characters.",
 	"lte.array":          "The {{.Attribute}} must not have more than {{.Value}} items.",
+	"lte.bytes":          "The {{.Attribute}} must be less than or equal {{.Value}} bytes.",
+	"lte.width":          "The {{.Attribute}} may not be wider than {{.Value}} columns.",
+	"mac":                "The {{.Attribute}} must be a valid MAC address.",
 	"max.numeric":        "The {{.Attribute}} may not be greater than {{.Max}}.",
 	"max.file":           "The {{.Attribute}} may not be greater than {{.Max}} kilobytes.",
 	"max.string":         "The {{.Attribute}} may not be greater than {{.Max}} characters.",
 	"max.array":          "The {{.Attribute}} may not have more than {{.Max}} items.",
+	"max.bytes":          "The {{.Attribute}} may not be greater than {{.Max}} bytes.",
+	"max.width":          "The {{.Attribute}} may not be wider than {{.Max}} columns.",
 	"mimes":              "The {{.Attribute}} must be a file of type: {{.Values}}.",
 	"mimetypes":          "The {{.Attribute}} must be a file of type: {{.Values}}.",
 	"min.numeric":        "The {{.Attribute}} must be at least {{.Min}}.",
 	"min.file":           "The {{.Attribute}} must be at least {{.Min}} kilobytes.",
 	"min.string":         "The {{.Attribute}} must be at least {{.Min}} characters.",
 	"min.array":          "The {{.Attribute}} must have at least {{.Min}} items.",
+	"min.bytes":          "The {{.Attribute}} must be at least {{.Min}} bytes.",
+	"min.width":          "The {{.Attribute}} must be at least {{.Min}} columns wide.",
+	"multipleOf":         "The {{.Attribute}} must be a multiple of {{.Value}}.",
+	"negative":           "The {{.Attribute}} must be a negative number.",
+	"nonZero":            "The {{.Attribute}} must not be zero.",
 	"notIn":              "The selected {{.Attribute}} is invalid.",
 	"notRegex":           "The {{.Attribute}} format is invalid.",
 	"numeric":            "The {{.Attribute}} must be a number.",
+	"password":           "The {{.Attribute}} is not strong enough.",
+	"phone":              "The {{.Attribute}} must be a valid phone number.",
+	"port":               "The {{.Attribute}} must be a valid port number.",
+	"positive":           "The {{.Attribute}} must be a positive number.",
+	"postcode":           "The {{.Attribute}} must be a valid postal code.",
+	"postcodeField":      "The {{.Attribute}} must be a valid postal code.",
 	"present":            "The {{.Attribute}} field must be present.",
+	"privateIp":          "The {{.Attribute}} must be a private IP address.",
+	"publicIp":           "The {{.Attribute}} must be a public IP address.",
 	"regex":              "The {{.Attribute}} format is invalid.",
 	"required":           "The {{.Attribute}} field is required.",
 	"requiredIf":         "The {{.Attribute}} field is required when {{.Other}} is {{.Value}}.",
@@ -75,17 +141,33 @@ var MessageMap = map[string]string{
 	"requiredWithAll":    "The {{.Attribute}} field is required when {{.Values}} is present.",
 	"requiredWithout":    "The {{.Attribute}} field is required when {{.Values}} is not present.",
 	"requiredWithoutAll": "The {{.Attribute}} field is required when none of {{.Values}} are present.",
+	"rgb":                "The {{.Attribute}} must be a valid RGB color.",
+	"rgba":               "The {{.Attribute}} must be a valid RGBA color.",
+	"safeUrl":            "The {{.Attribute}} must be an URL to a public host.",
 	"same":               "The {{.Attribute}} and {{.Other}} must match.",
+	"semver":             "The {{.Attribute}} must be a valid semantic version.",
+	"semverRange":        "The {{.Attribute}} must be a version satisfying {{.Values}}.",
 	"size.numeric":       "The {{.Attribute}} must be {{.Size}}.",
 	"size.file":          "The {{.Attribute}} must be {{.Size}} kilobytes.",
 	"size.string":        "The {{.Attribute}} must be {{.Size}} characters.",
 	"size.array":         "The {{.Attribute}} must contain {{.Size}} items.",
+	"size.bytes":         "The {{.Attribute}} must be {{.Size}} bytes.",
+	"size.width":         "The {{.Attribute}} must be {{.Size}} columns wide.",
 	"string":             "The {{.Attribute}} must be a string.",
 	"timezone":           "The {{.Attribute}} must be a valid zone.",
+	"ulid":               "The {{.Attribute}} must be a valid ULID.",
 	"unique":             "The {{.Attribute}} has already been taken.",
+	"unixAddr":           "The {{.Attribute}} must be a valid unix socket address.",
 	"uploaded":           "The {{.Attribute}} failed to upload.",
+	"url":                "The {{.Attribute}} format is invalid.",
+	"urlHost":            "The {{.Attribute}} must be an URL on one of the hosts: {{.Values}}.",
+	"urlRequireScheme":   "The {{.Attribute}} must be an absolute URL with a scheme and host.",
 	"uuid3":              "The {{.Attribute}} format is invalid.",
 	"uuid4":              "The {{.Attribute}} format is invalid.",
 	"uuid5":              "The {{.Attribute}} format is invalid.",
 	"uuid":               "The {{.Attribute}} format is invalid.",
+	"uuid1":              "The {{.Attribute}} format is invalid.",
+	"uuid6":              "The {{.Attribute}} format is invalid.",
+	"uuid7":              "The {{.Attribute}} format is invalid.",
+	"uuidAny":            "The {{.Attribute}} format is invalid.",
 }
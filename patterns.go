@@ -24,7 +24,17 @@ const (
 	UUID4            string = "^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
 	UUID5            string = "^[0-9a-f]{8}-[0-9a-f]{4}-5[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
 	UUID             string = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
-	CreditCard       string = "^(?:4[0-9]{12}(?:[0-9]{3})?|5[1-5][0-9]{14}|3[47][0-9]{13}|3[0-9]{13}|6(?:011|5[0-9]{2})[0-9]{12})$"
+	UUID1            string = "^[0-9a-f]{8}-[0-9a-f]{4}-1[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUID6            string = "^[0-9a-f]{8}-[0-9a-f]{4}-6[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUID7            string = "^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	UUIDAny          string = "^[0-9a-f]{8}-[0-9a-f]{4}-[1-8][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$"
+	ULID             string = "^[0-7][0-9A-HJKMNP-TV-Za-hjkmnp-tv-z]{25}$"
+	Semver           string = `^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`
+	CNResidentID     string = "^[1-9]\\d{16}[0-9X]$"
+	HKID             string = "^[A-Z]{1,2}\\d{6}(?:[0-9A]|\\([0-9A]\\))$"
+	CNSocialCredit   string = "^[0-9A-HJ-NPQRTUWXY]{2}\\d{6}[0-9A-HJ-NPQRTUWXY]{10}$"
+	CNLandline       string = "^(?:\\+86[- ]?|0)(?:10|2\\d|[3-9]\\d{2})[- ]?[2-9]\\d{6,7}$"
+	CreditCard       string = "^(?:4[0-9]{12}(?:[0-9]{3}(?:[0-9]{3})?)?|5[1-5][0-9]{14}|2(?:22[1-9]|2[3-9][0-9]|[3-6][0-9]{2}|7[01][0-9]|720)[0-9]{12}|3[47][0-9]{13}|3(?:0[0-5]|[68][0-9])[0-9]{11}|6(?:011|5[0-9]{2})[0-9]{12}|35(?:2[89]|[3-8][0-9])[0-9]{12}|62[0-9]{14,17})$"
 	ISBN10           string = "^(?:[0-9]{9}X|[0-9]{10})$"
 	ISBN13           string = "^(?:97[89][0-9]{10})$"
 	IP               string = `(([0-9a-fA-F]{1,4}:){7,7}[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,7}:|([0-9a-fA-F]{1,4}:){1,6}:[0-9a-fA-F]{1,4}|([0-9a-fA-F]{1,4}:){1,5}(:[0-9a-fA-F]{1,4}){1,2}|([0-9a-fA-F]{1,4}:){1,4}(:[0-9a-fA-F]{1,4}){1,3}|([0-9a-fA-F]{1,4}:){1,3}(:[0-9a-fA-F]{1,4}){1,4}|([0-9a-fA-F]{1,4}:){1,2}(:[0-9a-fA-F]{1,4}){1,5}|[0-9a-fA-F]{1,4}:((:[0-9a-fA-F]{1,4}){1,6})|:((:[0-9a-fA-F]{1,4}){1,7}|:)|fe80:(:[0-9a-fA-F]{0,4}){0,4}%[0-9a-zA-Z]{1,}|::(ffff(:0{1,4}){0,1}:){0,1}((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])|([0-9a-fA-F]{1,4}:){1,4}:((25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9])\.){3,3}(25[0-5]|(2[0-4]|1{0,1}[0-9]){0,1}[0-9]))`
@@ -57,6 +67,16 @@ var (
 	rxUUID4            = regexp.MustCompile(UUID4)
 	rxUUID5            = regexp.MustCompile(UUID5)
 	rxUUID             = regexp.MustCompile(UUID)
+	rxUUID1            = regexp.MustCompile(UUID1)
+	rxUUID6            = regexp.MustCompile(UUID6)
+	rxUUID7            = regexp.MustCompile(UUID7)
+	rxUUIDAny          = regexp.MustCompile(UUIDAny)
+	rxULID             = regexp.MustCompile(ULID)
+	rxSemver           = regexp.MustCompile(Semver)
+	rxCNResidentID     = regexp.MustCompile(CNResidentID)
+	rxHKID             = regexp.MustCompile(HKID)
+	rxCNSocialCredit   = regexp.MustCompile(CNSocialCredit)
+	rxCNLandline       = regexp.MustCompile(CNLandline)
 	rxAlpha            = regexp.MustCompile(Alpha)
 	rxAlphaNum         = regexp.MustCompile(AlphaNum)
 	rxAlphaDash        = regexp.MustCompile(AlphaDash)
@@ -0,0 +1,910 @@
+package validator
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"unicode/utf16"
+)
+
+// Signature describes how to recognise a file format from the start of its content.
+type Signature struct {
+	// MimeType is reported when the signature matches.
+	MimeType string
+	// Offset is the position of Magic in the content.
+	Offset int
+	// Magic is the byte sequence expected at Offset.
+	Magic []byte
+	// Match is used instead of Magic when set. It receives the start of the content.
+	Match func(header []byte) bool
+	// Optional marks signatures a format is not required to carry, such as a shebang line.
+	// Content without an optional signature may still be accepted as MimeType by the mimes rule.
+	Optional bool
+}
+
+func (s *Signature) matches(header []byte) bool {
+	if s.Match != nil {
+		return s.Match(header)
+	}
+	return len(header) >= s.Offset+len(s.Magic) && bytes.Equal(header[s.Offset:s.Offset+len(s.Magic)], s.Magic)
+}
+
+const (
+	mimeOctetStream = "application/octet-stream"
+	mimeTextPlain   = "text/plain"
+	mimeZip         = "application/zip"
+	mimeOLEStorage  = "application/x-ole-storage"
+	mimeXML         = "application/xml"
+	mimeJSON        = "application/json"
+	mimeHTML        = "text/html"
+)
+
+// minHeaderLen is the minimum number of bytes read to detect textual formats.
+const minHeaderLen = 4096
+
+// Sniffer detects the MIME type of file content from signatures, container
+// inspection for zip and OLE2 based formats, and the root element of XML documents.
+type Sniffer struct {
+	signatures []Signature
+	strict     map[string]bool
+	headerLen  int
+	mu         sync.RWMutex
+}
+
+// DefaultSniffer is the Sniffer used by the file rules.
+var DefaultSniffer = NewSniffer()
+
+// NewSniffer returns a new instance of Sniffer with the built-in signatures.
+func NewSniffer() *Sniffer {
+	s := &Sniffer{strict: make(map[string]bool), headerLen: minHeaderLen}
+	for _, mimeType := range containerMimeTypes {
+		s.strict[mimeType] = true
+	}
+	for _, mimeType := range xmlRootMimeTypes {
+		s.strict[mimeType] = true
+	}
+	s.strict[mimeHTML] = true
+	s.strict[mimeJSON] = true
+	s.register(builtinSignatures...)
+	return s
+}
+
+// Register adds signatures. They are checked before the built-in signatures,
+// the most recently registered first.
+func (s *Sniffer) Register(signatures ...Signature) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	reversed := make([]Signature, len(signatures))
+	for i, signature := range signatures {
+		reversed[len(signatures)-1-i] = signature
+	}
+	s.signatures = append(reversed, s.signatures...)
+	s.updateIndex(signatures)
+}
+
+func (s *Sniffer) register(signatures ...Signature) {
+	s.signatures = append(s.signatures, signatures...)
+	s.updateIndex(signatures)
+}
+
+func (s *Sniffer) updateIndex(signatures []Signature) {
+	for _, signature := range signatures {
+		if !signature.Optional {
+			s.strict[signature.MimeType] = true
+		}
+		if n := signature.Offset + len(signature.Magic); n > s.headerLen {
+			s.headerLen = n
+		}
+	}
+}
+
+// isStrict reports whether content of mimeType can always be recognised,
+// so that generic content must not be accepted for it.
+func (s *Sniffer) isStrict(mimeType string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.strict[mimeType]
+}
+
+// Detect returns the MIME type of data. It always returns a valid MIME type,
+// falling back to "application/octet-stream" or "text/plain".
+func (s *Sniffer) Detect(data []byte) string {
+	mimeType, _ := s.DetectReader(bytes.NewReader(data), int64(len(data)))
+	return mimeType
+}
+
+// DetectReader returns the MIME type of the content of r, which is size bytes long.
+func (s *Sniffer) DetectReader(r io.ReaderAt, size int64) (string, error) {
+	s.mu.RLock()
+	headerLen := s.headerLen
+	s.mu.RUnlock()
+
+	if size < int64(headerLen) {
+		headerLen = int(size)
+	}
+	header := make([]byte, headerLen)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	header = header[:n]
+
+	if mimeType := s.matchSignatures(header); mimeType != "" {
+		switch mimeType {
+		case mimeZip:
+			return inspectZip(r, size), nil
+		case mimeOLEStorage:
+			return inspectOLE(r, size), nil
+		}
+		return mimeType, nil
+	}
+
+	if !isText(header) {
+		if mimeType, ok := httpMimeTypeAliases[mediaTypeEssence(http.DetectContentType(header))]; ok {
+			return mimeType, nil
+		}
+		return mimeOctetStream, nil
+	}
+
+	return detectText(header, int64(n) == size), nil
+}
+
+func (s *Sniffer) matchSignatures(header []byte) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i := range s.signatures {
+		if s.signatures[i].matches(header) {
+			return s.signatures[i].MimeType
+		}
+	}
+	return ""
+}
+
+// isText reports whether header looks like text, using the binary data bytes of the WHATWG MIME Sniffing Standard.
+func isText(header []byte) bool {
+	if bytes.HasPrefix(header, []byte{0xFE, 0xFF}) || bytes.HasPrefix(header, []byte{0xFF, 0xFE}) {
+		return true
+	}
+	for _, b := range header {
+		if b <= 0x08 || b == 0x0B || (b >= 0x0E && b <= 0x1A) || (b >= 0x1C && b <= 0x1F) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectText returns the MIME type of textual content.
+func detectText(header []byte, complete bool) string {
+	trimmed := bytes.TrimLeft(bytes.TrimPrefix(header, []byte("\xEF\xBB\xBF")), "\t\n\x0C\r ")
+
+	switch {
+	case len(trimmed) == 0:
+		return mimeTextPlain
+	case trimmed[0] == '<':
+		if mimeType := detectXML(trimmed); mimeType != "" {
+			return mimeType
+		}
+		if mediaTypeEssence(http.DetectContentType(trimmed)) == mimeHTML {
+			return mimeHTML
+		}
+	case trimmed[0] == '{' || trimmed[0] == '[':
+		if isJSON(trimmed, complete) {
+			return mimeJSON
+		}
+	}
+
+	return mimeTextPlain
+}
+
+// isJSON reports whether data is JSON. A truncated header is accepted while it stays well formed.
+func isJSON(data []byte, complete bool) bool {
+	if complete {
+		return json.Valid(data)
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			return err == io.EOF || err == io.ErrUnexpectedEOF
+		}
+	}
+}
+
+// xmlRootMimeTypes maps the local name of an XML root element to a MIME type.
+var xmlRootMimeTypes = map[string]string{
+	"svg":            "image/svg+xml",
+	"rss":            "application/rss+xml",
+	"feed":           "application/atom+xml",
+	"RDF":            "application/rdf+xml",
+	"kml":            "application/vnd.google-earth.kml+xml",
+	"gpx":            "application/gpx+xml",
+	"math":           "application/mathml+xml",
+	"stylesheet":     "application/xslt+xml",
+	"smil":           "application/smil+xml",
+	"xliff":          "application/x-xliff+xml",
+	"playlist":       "application/xspf+xml",
+	"score-partwise": "application/vnd.recordare.musicxml+xml",
+	"score-timewise": "application/vnd.recordare.musicxml+xml",
+	"COLLADA":        "model/vnd.collada+xml",
+	"X3D":            "model/x3d+xml",
+	"opml":           "text/x-opml",
+	"ncx":            "application/x-dtbncx+xml",
+	"package":        "application/oebps-package+xml",
+	"xhtml":          "application/xhtml+xml",
+	"html":           mimeHTML,
+	"dtbook":         "application/x-dtbook+xml",
+}
+
+// detectXML returns the MIME type of an XML document from its root element,
+// or "" when data is not XML.
+func detectXML(data []byte) string {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	for {
+		token, err := decoder.RawToken()
+		if err != nil {
+			return ""
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			local := t.Name.Local
+			if mimeType, ok := xmlRootMimeTypes[local]; ok {
+				if local == "html" {
+					for _, attr := range t.Attr {
+						if attr.Name.Local == "xmlns" && attr.Value == "http://www.w3.org/1999/xhtml" {
+							return "application/xhtml+xml"
+						}
+					}
+				}
+				return mimeType
+			}
+			if isHTMLElement(local) {
+				return mimeHTML
+			}
+			return mimeXML
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return ""
+			}
+		}
+	}
+}
+
+// isHTMLElement reports whether name is an element that starts an HTML fragment.
+func isHTMLElement(name string) bool {
+	switch strings.ToLower(name) {
+	case "html", "head", "body", "script", "iframe", "h1", "div", "font", "table", "a", "style", "title", "b", "br", "p":
+		return true
+	}
+	return false
+}
+
+// httpMimeTypeAliases maps types reported by http.DetectContentType to the names used in Mimes.
+var httpMimeTypeAliases = map[string]string{
+	"application/x-gzip":           "application/gzip",
+	"application/wasm":             "application/wasm",
+	"font/woff2":                   "font/woff2",
+	"font/collection":              "font/collection",
+	"audio/wave":                   "audio/x-wav",
+	"video/avi":                    "video/x-msvideo",
+	"audio/aiff":                   "audio/x-aiff",
+	"font/ttf":                     "application/x-font-ttf",
+	"font/otf":                     "application/x-font-otf",
+	"font/woff":                    "application/x-font-woff",
+	"application/x-rar-compressed": "application/x-rar",
+}
+
+// containerMimeTypes are the types reported by zip and OLE2 container inspection.
+var containerMimeTypes = []string{
+	"application/epub+zip",
+	"application/vnd.adobe.air-application-installer-package+zip",
+	"application/vnd.oasis.opendocument.chart",
+	"application/vnd.oasis.opendocument.chart-template",
+	"application/vnd.oasis.opendocument.database",
+	"application/vnd.oasis.opendocument.formula",
+	"application/vnd.oasis.opendocument.formula-template",
+	"application/vnd.oasis.opendocument.graphics",
+	"application/vnd.oasis.opendocument.graphics-template",
+	"application/vnd.oasis.opendocument.image",
+	"application/vnd.oasis.opendocument.image-template",
+	"application/vnd.oasis.opendocument.presentation",
+	"application/vnd.oasis.opendocument.presentation-template",
+	"application/vnd.oasis.opendocument.spreadsheet",
+	"application/vnd.oasis.opendocument.spreadsheet-template",
+	"application/vnd.oasis.opendocument.text",
+	"application/vnd.oasis.opendocument.text-master",
+	"application/vnd.oasis.opendocument.text-template",
+	"application/vnd.oasis.opendocument.text-web",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template",
+	"application/vnd.ms-word.document.macroenabled.12",
+	"application/vnd.ms-word.template.macroenabled.12",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template",
+	"application/vnd.ms-excel.sheet.macroenabled.12",
+	"application/vnd.ms-excel.template.macroenabled.12",
+	"application/vnd.ms-excel.addin.macroenabled.12",
+	"application/vnd.ms-excel.sheet.binary.macroenabled.12",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow",
+	"application/vnd.openxmlformats-officedocument.presentationml.template",
+	"application/vnd.ms-powerpoint.presentation.macroenabled.12",
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.12",
+	"application/vnd.ms-powerpoint.template.macroenabled.12",
+	"application/vnd.ms-powerpoint.addin.macroenabled.12",
+	"application/vnd.ms-xpsdocument",
+	"application/oxps",
+	"application/java-archive",
+	"application/vnd.android.package-archive",
+	"application/x-xpinstall",
+	"application/x-silverlight-app",
+	"application/vnd.google-earth.kmz",
+	"application/msword",
+	"application/vnd.ms-excel",
+	"application/vnd.ms-powerpoint",
+	"application/vnd.visio",
+	"application/x-mspublisher",
+}
+
+// isContainerMimeType reports whether mimeType is one of the containerMimeTypes.
+func isContainerMimeType(mimeType string) bool {
+	for _, containerType := range containerMimeTypes {
+		if mimeType == containerType {
+			return true
+		}
+	}
+	return false
+}
+
+// ooxmlMainContentTypes maps the content type of the main part of an Office Open XML package to the package type.
+var ooxmlMainContentTypes = map[string]string{
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml":   "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.template.main+xml":   "application/vnd.openxmlformats-officedocument.wordprocessingml.template",
+	"application/vnd.ms-word.document.macroenabled.main+xml":                             "application/vnd.ms-word.document.macroenabled.12",
+	"application/vnd.ms-word.template.macroenabledtemplate.main+xml":                     "application/vnd.ms-word.template.macroenabled.12",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml":         "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.template.main+xml":      "application/vnd.openxmlformats-officedocument.spreadsheetml.template",
+	"application/vnd.ms-excel.sheet.macroenabled.main+xml":                               "application/vnd.ms-excel.sheet.macroenabled.12",
+	"application/vnd.ms-excel.template.macroenabled.main+xml":                            "application/vnd.ms-excel.template.macroenabled.12",
+	"application/vnd.ms-excel.addin.macroenabled.main+xml":                               "application/vnd.ms-excel.addin.macroenabled.12",
+	"application/vnd.ms-excel.sheet.binary.macroenabled.main":                            "application/vnd.ms-excel.sheet.binary.macroenabled.12",
+	"application/vnd.openxmlformats-officedocument.presentationml.presentation.main+xml": "application/vnd.openxmlformats-officedocument.presentationml.presentation",
+	"application/vnd.openxmlformats-officedocument.presentationml.slideshow.main+xml":    "application/vnd.openxmlformats-officedocument.presentationml.slideshow",
+	"application/vnd.openxmlformats-officedocument.presentationml.template.main+xml":     "application/vnd.openxmlformats-officedocument.presentationml.template",
+	"application/vnd.ms-powerpoint.presentation.macroenabled.main+xml":                   "application/vnd.ms-powerpoint.presentation.macroenabled.12",
+	"application/vnd.ms-powerpoint.slideshow.macroenabled.main+xml":                      "application/vnd.ms-powerpoint.slideshow.macroenabled.12",
+	"application/vnd.ms-powerpoint.template.macroenabled.main+xml":                       "application/vnd.ms-powerpoint.template.macroenabled.12",
+	"application/vnd.ms-powerpoint.addin.macroenabled.main+xml":                          "application/vnd.ms-powerpoint.addin.macroenabled.12",
+	"application/vnd.ms-package.xps-fixeddocumentsequence+xml":                           "application/vnd.ms-xpsdocument",
+	"application/vnd.openxps-fixeddocumentsequence+xml":                                  "application/oxps",
+}
+
+// maxContainerEntry limits how much of a single container entry is read during inspection.
+const maxContainerEntry = 1 << 20
+
+// inspectZip returns the type of a zip based format, or "application/zip".
+func inspectZip(r io.ReaderAt, size int64) string {
+	reader, err := zip.NewReader(r, size)
+	if err != nil {
+		return mimeZip
+	}
+
+	entries := make(map[string]*zip.File, len(reader.File))
+	for _, file := range reader.File {
+		entries[file.Name] = file
+	}
+
+	// OpenDocument, EPUB and AIR packages name their type in a "mimetype" entry. Only the container types are
+	// trusted, as any archive can claim to be an image there.
+	if file, ok := entries["mimetype"]; ok {
+		if content, err := readZipEntry(file, 256); err == nil {
+			if mimeType := strings.ToLower(strings.TrimSpace(string(content))); isContainerMimeType(mimeType) {
+				return mimeType
+			}
+		}
+	}
+
+	if file, ok := entries["[Content_Types].xml"]; ok {
+		if content, err := readZipEntry(file, maxContainerEntry); err == nil {
+			if mimeType := ooxmlPackageType(content); mimeType != "" {
+				return mimeType
+			}
+		}
+	}
+
+	switch {
+	case entries["AndroidManifest.xml"] != nil && entries["classes.dex"] != nil:
+		return "application/vnd.android.package-archive"
+	case entries["META-INF/MANIFEST.MF"] != nil:
+		return "application/java-archive"
+	case entries["install.rdf"] != nil:
+		return "application/x-xpinstall"
+	case entries["AppManifest.xaml"] != nil:
+		return "application/x-silverlight-app"
+	}
+
+	for name := range entries {
+		if !strings.Contains(name, "/") && path.Ext(name) == ".kml" {
+			return "application/vnd.google-earth.kmz"
+		}
+	}
+
+	return mimeZip
+}
+
+func readZipEntry(file *zip.File, limit int64) ([]byte, error) {
+	rc, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(io.LimitReader(rc, limit))
+}
+
+// ooxmlPackageType returns the package type named by the Override elements of [Content_Types].xml.
+func ooxmlPackageType(content []byte) string {
+	var types struct {
+		Overrides []struct {
+			ContentType string `xml:"ContentType,attr"`
+		} `xml:"Override"`
+	}
+	if err := xml.Unmarshal(content, &types); err != nil {
+		return ""
+	}
+	for _, override := range types.Overrides {
+		if mimeType, ok := ooxmlMainContentTypes[strings.ToLower(override.ContentType)]; ok {
+			return mimeType
+		}
+	}
+	return ""
+}
+
+// oleStreamMimeTypes maps a stream or storage name of an OLE2 compound file to the document type.
+var oleStreamMimeTypes = map[string]string{
+	"WordDocument":        "application/msword",
+	"Workbook":            "application/vnd.ms-excel",
+	"Book":                "application/vnd.ms-excel",
+	"PowerPoint Document": "application/vnd.ms-powerpoint",
+	"VisioDocument":       "application/vnd.visio",
+	"Quill":               "application/x-mspublisher",
+}
+
+// inspectOLE returns the type of an OLE2 compound file from its directory entries,
+// or "application/x-ole-storage".
+func inspectOLE(r io.ReaderAt, size int64) string {
+	for _, name := range oleDirectoryNames(r, size) {
+		if mimeType, ok := oleStreamMimeTypes[name]; ok {
+			return mimeType
+		}
+	}
+	return mimeOLEStorage
+}
+
+// oleDirectoryNames returns the names of the directory entries of an OLE2 compound file.
+func oleDirectoryNames(r io.ReaderAt, size int64) []string {
+	const (
+		endOfChain    = 0xFFFFFFFE
+		maxSectors    = 4096
+		headerFATSlot = 109
+	)
+
+	header := make([]byte, 512)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil
+	}
+	sectorShift := binary.LittleEndian.Uint16(header[0x1E:])
+	if sectorShift != 9 && sectorShift != 12 {
+		return nil
+	}
+	sectorSize := int64(1) << sectorShift
+	numFATSectors := binary.LittleEndian.Uint32(header[0x2C:])
+	firstDirSector := binary.LittleEndian.Uint32(header[0x30:])
+
+	readSector := func(n uint32) []byte {
+		offset := (int64(n) + 1) * sectorSize
+		if offset+sectorSize > size {
+			return nil
+		}
+		sector := make([]byte, sectorSize)
+		if _, err := r.ReadAt(sector, offset); err != nil {
+			return nil
+		}
+		return sector
+	}
+
+	var fat []uint32
+	for i := uint32(0); i < numFATSectors && i < headerFATSlot; i++ {
+		sector := readSector(binary.LittleEndian.Uint32(header[0x4C+4*i:]))
+		for j := 0; j+4 <= len(sector); j += 4 {
+			fat = append(fat, binary.LittleEndian.Uint32(sector[j:]))
+		}
+	}
+
+	var names []string
+	for n, count := firstDirSector, 0; n != endOfChain && count < maxSectors; count++ {
+		sector := readSector(n)
+		if sector == nil {
+			break
+		}
+		for entry := 0; entry+128 <= len(sector); entry += 128 {
+			nameLen := int(binary.LittleEndian.Uint16(sector[entry+0x40:]))
+			if nameLen < 2 || nameLen > 64 {
+				continue
+			}
+			units := make([]uint16, nameLen/2-1)
+			for i := range units {
+				units[i] = binary.LittleEndian.Uint16(sector[entry+2*i:])
+			}
+			names = append(names, string(utf16.Decode(units)))
+		}
+		if int(n) >= len(fat) {
+			break
+		}
+		n = fat[n]
+	}
+	return names
+}
+
+// magic returns a Signature matching m at offset 0.
+func magic(mimeType, m string) Signature {
+	return Signature{MimeType: mimeType, Magic: []byte(m)}
+}
+
+// magicAt returns a Signature matching m at offset.
+func magicAt(mimeType string, offset int, m string) Signature {
+	return Signature{MimeType: mimeType, Offset: offset, Magic: []byte(m)}
+}
+
+// optionalMagic returns an optional Signature matching m at offset 0.
+func optionalMagic(mimeType, m string) Signature {
+	return Signature{MimeType: mimeType, Magic: []byte(m), Optional: true}
+}
+
+// chunk returns a Signature matching a container header at offset 0 and a form type at offset 8,
+// as used by RIFF and IFF files.
+func chunk(mimeType, container, form string) Signature {
+	return Signature{MimeType: mimeType, Match: func(header []byte) bool {
+		return bytes.HasPrefix(header, []byte(container)) && len(header) >= 12 && string(header[8:12]) == form
+	}}
+}
+
+// ftyp returns a Signature matching an ISO base media file whose major brand starts with one of brands.
+func ftyp(mimeType string, brands ...string) Signature {
+	return Signature{MimeType: mimeType, Match: func(header []byte) bool {
+		if len(header) < 12 || string(header[4:8]) != "ftyp" {
+			return false
+		}
+		for _, brand := range brands {
+			if strings.HasPrefix(string(header[8:12]), brand) {
+				return true
+			}
+		}
+		return false
+	}}
+}
+
+// ogg returns a Signature matching an Ogg stream whose first packet starts with one of codecs.
+func ogg(mimeType string, codecs ...string) Signature {
+	return Signature{MimeType: mimeType, Match: func(header []byte) bool {
+		if !bytes.HasPrefix(header, []byte("OggS")) || len(header) < 28 {
+			return false
+		}
+		for _, codec := range codecs {
+			if bytes.HasPrefix(header[28:], []byte(codec)) {
+				return true
+			}
+		}
+		return false
+	}}
+}
+
+// ebml returns a Signature matching an EBML file with the given DocType.
+func ebml(mimeType, docType string) Signature {
+	return Signature{MimeType: mimeType, Match: func(header []byte) bool {
+		if !bytes.HasPrefix(header, []byte("\x1A\x45\xDF\xA3")) {
+			return false
+		}
+		end := len(header)
+		if end > 64 {
+			end = 64
+		}
+		return bytes.Contains(header[4:end], []byte(docType))
+	}}
+}
+
+// builtinSignatures are checked in order, so more specific signatures come first.
+var builtinSignatures = []Signature{
+	// Images
+	magic("image/png", "\x89PNG\r\n\x1A\n"),
+	magic("image/jpeg", "\xFF\xD8\xFF"),
+	magic("image/gif", "GIF87a"),
+	magic("image/gif", "GIF89a"),
+	chunk("image/webp", "RIFF", "WEBP"),
+	magic("image/tiff", "II*\x00"),
+	magic("image/tiff", "MM\x00*"),
+	magic("image/vnd.ms-photo", "II\xBC"),
+	magic("image/vnd.adobe.photoshop", "8BPS"),
+	magic("image/ktx", "\xABKTX 11\xBB\r\n\x1A\n"),
+	magic("image/vnd.djvu", "AT&TFORM"),
+	magic("image/vnd.dwg", "AC10"),
+	magic("image/x-icon", "\x00\x00\x01\x00"),
+	magic("image/sgi", "\x01\xDA"),
+	magic("image/x-cmu-raster", "\x59\xA6\x6A\x95"),
+	magic("image/x-mrsid-image", "msid"),
+	magic("image/x-xpixmap", "/* XPM */"),
+	magic("image/x-portable-bitmap", "P1\n"),
+	magic("image/x-portable-bitmap", "P4\n"),
+	magic("image/x-portable-graymap", "P2\n"),
+	magic("image/x-portable-graymap", "P5\n"),
+	magic("image/x-portable-pixmap", "P3\n"),
+	magic("image/x-portable-pixmap", "P6\n"),
+	magic("image/bmp", "BM"),
+	{MimeType: "image/x-pcx", Match: func(header []byte) bool {
+		return len(header) >= 3 && header[0] == 0x0A && header[1] <= 5 && header[2] == 1
+	}},
+
+	// Audio and video
+	magic("audio/x-flac", "fLaC"),
+	magic("audio/mpeg", "ID3"),
+	magic("audio/mpeg", "\xFF\xFB"),
+	magic("audio/mpeg", "\xFF\xF3"),
+	magic("audio/mpeg", "\xFF\xF2"),
+	magic("audio/x-aac", "\xFF\xF1"),
+	magic("audio/x-aac", "\xFF\xF9"),
+	chunk("audio/x-wav", "RIFF", "WAVE"),
+	chunk("video/x-msvideo", "RIFF", "AVI "),
+	chunk("audio/midi", "RIFF", "RMID"),
+	chunk("audio/x-aiff", "FORM", "AIFF"),
+	chunk("audio/x-aiff", "FORM", "AIFC"),
+	chunk("application/x-blorb", "FORM", "IFRS"),
+	magic("audio/midi", "MThd"),
+	magic("audio/basic", ".snd"),
+	magic("audio/x-caf", "caff"),
+	magic("audio/xm", "Extended Module: "),
+	magicAt("audio/s3m", 44, "SCRM"),
+	magic("audio/vnd.dts", "\x7F\xFE\x80\x01"),
+	magic("audio/silk", "#!SILK"),
+	ogg("audio/ogg", "\x01vorbis", "OpusHead", "Speex   ", "\x7FFLAC"),
+	ogg("video/ogg", "\x80theora"),
+	magic("application/ogg", "OggS"),
+	ebml("video/webm", "webm"),
+	ebml("video/x-matroska", "matroska"),
+	ftyp("audio/mp4", "M4A ", "M4B ", "M4P "),
+	ftyp("video/x-m4v", "M4V"),
+	ftyp("video/quicktime", "qt  "),
+	ftyp("video/3gpp2", "3g2"),
+	ftyp("video/3gpp", "3gp", "3ge", "3gg", "3gs"),
+	ftyp("video/x-f4v", "F4V ", "f4v "),
+	ftyp("video/mj2", "mjp2", "mj2s"),
+	ftyp("video/jpm", "jpm "),
+	ftyp("video/mp4", ""),
+	magicAt("video/quicktime", 4, "moov"),
+	magicAt("video/quicktime", 4, "mdat"),
+	magicAt("video/quicktime", 4, "wide"),
+	magic("video/x-flv", "FLV\x01"),
+	magic("video/mpeg", "\x00\x00\x01\xBA"),
+	magic("video/mpeg", "\x00\x00\x01\xB3"),
+	magic("video/x-ms-asf", "\x30\x26\xB2\x75\x8E\x66\xCF\x11\xA6\xD9\x00\xAA\x00\x62\xCE\x6C"),
+	magic("video/x-mng", "\x8AMNG\r\n\x1A\n"),
+	magic("video/x-sgi-movie", "MOVI"),
+	magicAt("video/x-fli", 4, "\x11\xAF"),
+	magic("application/vnd.rn-realmedia", ".RMF"),
+	magic("application/x-shockwave-flash", "FWS"),
+	magic("application/x-shockwave-flash", "CWS"),
+	magic("application/x-shockwave-flash", "ZWS"),
+
+	// Documents
+	magic("application/pdf", "%PDF-"),
+	magic("application/vnd.fdf", "%FDF-"),
+	magic("application/x-font-type1", "%!PS-AdobeFont"),
+	magic("application/postscript", "%!PS"),
+	magic("application/postscript", "\xC5\xD0\xD3\xC6"),
+	magic("text/rtf", "{\\rtf"),
+	magic("application/x-dvi", "\xF7\x02"),
+	magic("application/vnd.ms-htmlhelp", "ITSF"),
+	magicAt("application/x-mobipocket-ebook", 60, "BOOKMOBI"),
+	magic("text/calendar", "BEGIN:VCALENDAR"),
+	magic("text/vcard", "BEGIN:VCARD"),
+	magic("application/x-bittorrent", "d8:announce"),
+
+	// Archives and containers
+	magic(mimeZip, "PK\x03\x04"),
+	magic(mimeZip, "PK\x05\x06"),
+	magic(mimeZip, "PK\x07\x08"),
+	magic(mimeOLEStorage, "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1"),
+	magic("application/x-7z-compressed", "7z\xBC\xAF\x27\x1C"),
+	magic("application/x-rar", "Rar!\x1A\x07"),
+	magic("application/x-bzip2", "BZh"),
+	magic("application/x-bzip", "BZ0"),
+	magic("application/x-xz", "\xFD7zXZ\x00"),
+	magic("application/gzip", "\x1F\x8B"),
+	magicAt("application/x-tar", 257, "ustar"),
+	magicAt("application/x-lzh-compressed", 2, "-lh"),
+	magicAt("application/x-ace-compressed", 7, "**ACE**"),
+	magic("application/vnd.ms-cab-compressed", "MSCF"),
+	magic("application/x-sv4crc", "070702"),
+	magic("application/x-sv4cpio", "070701"),
+	magic("application/x-cpio", "070707"),
+	magic("application/x-cpio", "\xC7\x71"),
+	magic("application/x-debian-package", "!<arch>\ndebian-binary"),
+	magicAt("application/x-iso9660-image", 32769, "CD001"),
+	magic("application/x-stuffit", "SIT!"),
+	magic("application/x-stuffit", "StuffIt (c)1997"),
+	magic("application/x-stuffitx", "StuffIt!"),
+
+	// Executables and binary data
+	magic("application/x-msdownload", "MZ"),
+	magic("application/java-vm", "\xCA\xFE\xBA\xBE"),
+	magic("application/java-serialized-object", "\xAC\xED\x00\x05"),
+	magicAt("application/x-msaccess", 4, "Standard Jet DB"),
+	magicAt("application/x-msaccess", 4, "Standard ACE DB"),
+	magic("application/vnd.tcpdump.pcap", "\xD4\xC3\xB2\xA1"),
+	magic("application/vnd.tcpdump.pcap", "\xA1\xB2\xC3\xD4"),
+	magic("application/vnd.tcpdump.pcap", "\x4D\x3C\xB2\xA1"),
+	magic("application/x-ms-shortcut", "\x4C\x00\x00\x00\x01\x14\x02\x00"),
+	magic("application/x-msmetafile", "\xD7\xCD\xC6\x9A"),
+	magic("application/x-hdf", "\x89HDF\r\n\x1A\n"),
+	magic("application/x-hdf", "\x0E\x03\x13\x01"),
+	magic("application/x-netcdf", "CDF\x01"),
+	magic("application/x-netcdf", "CDF\x02"),
+
+	// Fonts
+	magic("application/x-font-otf", "OTTO"),
+	magic("application/x-font-ttf", "\x00\x01\x00\x00\x00"),
+	magic("application/x-font-woff", "wOFF"),
+	magicAt("application/vnd.ms-fontobject", 34, "LP"),
+
+	// Text formats that usually, but not always, announce themselves
+	optionalMagic("application/x-sh", "#!/bin/sh"),
+	optionalMagic("application/x-sh", "#!/bin/bash"),
+	optionalMagic("application/x-sh", "#!/usr/bin/env sh"),
+	optionalMagic("application/x-sh", "#!/usr/bin/env bash"),
+	optionalMagic("application/x-csh", "#!/bin/csh"),
+	optionalMagic("application/x-csh", "#!/bin/tcsh"),
+	optionalMagic("application/x-tcl", "#!/usr/bin/tclsh"),
+	optionalMagic("application/x-tcl", "#!/usr/bin/env tclsh"),
+	optionalMagic("audio/x-mpegurl", "#EXTM3U"),
+	optionalMagic("image/x-xbitmap", "#define "),
+}
+
+// compatibleMimeTypes lists, for a MIME type, the detected types that also satisfy it.
+var compatibleMimeTypes = map[string][]string{
+	"application/mp4":                  {"video/mp4", "audio/mp4"},
+	"application/ogg":                  {"audio/ogg", "video/ogg"},
+	"audio/webm":                       {"video/webm"},
+	"audio/x-matroska":                 {"video/x-matroska"},
+	"video/vnd.uvvu.mp4":               {"video/mp4"},
+	"image/x-portable-anymap":          {"image/x-portable-bitmap", "image/x-portable-graymap", "image/x-portable-pixmap"},
+	"audio/x-ms-wma":                   {"video/x-ms-asf"},
+	"video/x-ms-wmv":                   {"video/x-ms-asf"},
+	"video/x-ms-wm":                    {"video/x-ms-asf"},
+	"video/x-ms-vob":                   {"video/mpeg"},
+	"application/vnd.rn-realmedia-vbr": {"application/vnd.rn-realmedia"},
+	"application/vnd.amazon.ebook":     {"application/x-mobipocket-ebook"},
+	"application/vnd.palm":             {"application/x-mobipocket-ebook"},
+	"text/x-vcard":                     {"text/vcard"},
+	"text/x-vcalendar":                 {"text/calendar"},
+	"application/vnd.apple.mpegurl":    {"audio/x-mpegurl"},
+	"application/x-ustar":              {"application/x-tar"},
+	"application/x-gtar":               {"application/gzip", "application/x-tar"},
+}
+
+// zipBasedMimeTypes are formats stored in a zip container that inspection cannot always name.
+var zipBasedMimeTypes = map[string]bool{
+	"application/vnd.openxmlformats-officedocument.presentationml.slide": true,
+	"application/vnd.ms-powerpoint.slide.macroenabled.12":                true,
+	"application/vnd.ms-officetheme":                                     true,
+	"application/vnd.recordare.musicxml":                                 true,
+	"application/widget":                                                 true,
+	"application/vnd.olpc-sugar":                                         true,
+	"application/vnd.stepmania.package":                                  true,
+	"application/vnd.dece.zip":                                           true,
+	"application/vnd.ezpix-package":                                      true,
+	"application/x-cbr":                                                  true,
+	"application/vnd.openofficeorg.extension":                            true,
+	"application/vnd.sun.xml.calc":                                       true,
+	"application/vnd.sun.xml.calc.template":                              true,
+	"application/vnd.sun.xml.draw":                                       true,
+	"application/vnd.sun.xml.draw.template":                              true,
+	"application/vnd.sun.xml.impress":                                    true,
+	"application/vnd.sun.xml.impress.template":                           true,
+	"application/vnd.sun.xml.math":                                       true,
+	"application/vnd.sun.xml.writer":                                     true,
+	"application/vnd.sun.xml.writer.global":                              true,
+	"application/vnd.sun.xml.writer.template":                            true,
+}
+
+// oleBasedMimeTypes are formats stored in an OLE2 compound file that inspection cannot always name.
+var oleBasedMimeTypes = map[string]bool{
+	"application/vnd.ms-project": true,
+	"application/vnd.ms-works":   true,
+	"application/x-msmoney":      true,
+	"image/vnd.fpx":              true,
+}
+
+// textualMimeTypes are textual formats that neither start with "text/" nor end with "+xml" or "+json".
+var textualMimeTypes = map[string]bool{
+	mimeXML:                                 true,
+	mimeJSON:                                true,
+	"application/javascript":                true,
+	"application/ecmascript":                true,
+	"application/x-sh":                      true,
+	"application/x-csh":                     true,
+	"application/x-tcl":                     true,
+	"application/x-tex":                     true,
+	"application/x-latex":                   true,
+	"application/x-texinfo":                 true,
+	"application/x-sql":                     true,
+	"application/x-subrip":                  true,
+	"application/x-chess-pgn":               true,
+	"application/mbox":                      true,
+	"application/sparql-query":              true,
+	"application/srgs":                      true,
+	"application/relax-ng-compact-syntax":   true,
+	"application/x-java-jnlp-file":          true,
+	"application/xml-dtd":                   true,
+	"application/x-research-info-systems":   true,
+	"application/x-install-instructions":    true,
+	"application/x-ms-application":          true,
+	"application/x-ms-xbap":                 true,
+	"application/x-nzb":                     true,
+	"application/x-gramps-xml":              true,
+	"application/vnd.adobe.xfdf":            true,
+	"application/pgp-signature":             true,
+	"audio/x-mpegurl":                       true,
+	"application/vnd.apple.mpegurl":         true,
+	"audio/x-ms-wax":                        true,
+	"video/x-ms-wvx":                        true,
+	"video/x-ms-wmx":                        true,
+	"video/vnd.mpegurl":                     true,
+	"audio/x-pn-realaudio":                  true,
+	"message/rfc822":                        true,
+	"model/vrml":                            true,
+	"model/x3d+vrml":                        true,
+	"image/x-xbitmap":                       true,
+	"image/x-xpixmap":                       true,
+	"chemical/x-xyz":                        true,
+	"chemical/x-cif":                        true,
+	"chemical/x-cml":                        true,
+	"chemical/x-csml":                       true,
+	"application/vnd.google-earth.kml+xml":  true,
+	"application/vnd.hal+xml":               true,
+	"application/x-font-bdf":                true,
+	"application/vnd.dece.ttml+xml":         true,
+	"application/vnd.route66.link66+xml":    true,
+	"application/x-dtbresource+xml":         true,
+	"application/x-xliff+xml":               true,
+	"application/vnd.criticaltools.wbs+xml": true,
+}
+
+// isTextualMimeType reports whether content of mimeType is text.
+func isTextualMimeType(mimeType string) bool {
+	return strings.HasPrefix(mimeType, "text/") ||
+		strings.HasSuffix(mimeType, "+xml") ||
+		strings.HasSuffix(mimeType, "+json") ||
+		textualMimeTypes[mimeType]
+}
+
+// genericMimeTypes returns the generic types detected for content of mimeType
+// when it carries no signature of its own.
+func genericMimeTypes(mimeType string) []string {
+	switch {
+	case strings.HasSuffix(mimeType, "+zip") || zipBasedMimeTypes[mimeType]:
+		return []string{mimeZip}
+	case oleBasedMimeTypes[mimeType]:
+		return []string{mimeOLEStorage}
+	case isTextualMimeType(mimeType):
+		return []string{mimeTextPlain, mimeXML, mimeJSON}
+	}
+	return []string{mimeOctetStream}
+}
@@ -0,0 +1,330 @@
+package validator
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"mime/multipart"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+// newTestZip builds a zip archive from name and content pairs, in order.
+func newTestZip(t *testing.T, entries ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := zip.NewWriter(&buf)
+	for i := 0; i+1 < len(entries); i += 2 {
+		w, err := writer.CreateHeader(&zip.FileHeader{Name: entries[i], Method: zip.Store})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(entries[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// newTestOLE builds an OLE2 compound file with a single stream called name.
+func newTestOLE(name string) []byte {
+	const sectorSize = 512
+	data := make([]byte, 3*sectorSize)
+
+	header := data[:sectorSize]
+	copy(header, "\xD0\xCF\x11\xE0\xA1\xB1\x1A\xE1")
+	binary.LittleEndian.PutUint16(header[0x1A:], 3)
+	binary.LittleEndian.PutUint16(header[0x1C:], 0xFFFE)
+	binary.LittleEndian.PutUint16(header[0x1E:], 9)
+	binary.LittleEndian.PutUint32(header[0x2C:], 1)
+	binary.LittleEndian.PutUint32(header[0x30:], 1)
+	for i := 0; i < 109; i++ {
+		binary.LittleEndian.PutUint32(header[0x4C+4*i:], 0xFFFFFFFF)
+	}
+	binary.LittleEndian.PutUint32(header[0x4C:], 0)
+
+	fat := data[sectorSize : 2*sectorSize]
+	for i := 0; i < sectorSize; i += 4 {
+		binary.LittleEndian.PutUint32(fat[i:], 0xFFFFFFFF)
+	}
+	binary.LittleEndian.PutUint32(fat[0:], 0xFFFFFFFD)
+	binary.LittleEndian.PutUint32(fat[4:], 0xFFFFFFFE)
+
+	directory := data[2*sectorSize:]
+	for i, entry := range []string{"Root Entry", name} {
+		units := utf16.Encode([]rune(entry))
+		for j, unit := range units {
+			binary.LittleEndian.PutUint16(directory[128*i+2*j:], unit)
+		}
+		binary.LittleEndian.PutUint16(directory[128*i+0x40:], uint16(2*len(units)+2))
+	}
+	return data
+}
+
+// testSamples returns content for every MIME type the sniffer recognises without a plain magic number.
+func testSamples(t *testing.T) map[string][]byte {
+	samples := map[string][]byte{
+		"application/json":                 []byte(`{"name": "value"}`),
+		"text/html":                        []byte("<!DOCTYPE html>\n<html><body></body></html>"),
+		"application/xhtml+xml":            []byte(`<?xml version="1.0"?><html xmlns="http://www.w3.org/1999/xhtml"></html>`),
+		"image/webp":                       []byte("RIFF\x24\x00\x00\x00WEBPVP8 "),
+		"audio/x-wav":                      []byte("RIFF\x24\x00\x00\x00WAVEfmt "),
+		"video/x-msvideo":                  []byte("RIFF\x24\x00\x00\x00AVI LIST"),
+		"audio/x-aiff":                     []byte("FORM\x00\x00\x00\x24AIFFCOMM"),
+		"application/x-blorb":              []byte("FORM\x00\x00\x00\x24IFRSRIdx"),
+		"video/mp4":                        []byte("\x00\x00\x00\x18ftypisom\x00\x00\x02\x00"),
+		"audio/mp4":                        []byte("\x00\x00\x00\x18ftypM4A \x00\x00\x02\x00"),
+		"video/x-m4v":                      []byte("\x00\x00\x00\x18ftypM4V \x00\x00\x02\x00"),
+		"video/3gpp":                       []byte("\x00\x00\x00\x18ftyp3gp5\x00\x00\x02\x00"),
+		"video/3gpp2":                      []byte("\x00\x00\x00\x18ftyp3g2a\x00\x00\x02\x00"),
+		"video/x-f4v":                      []byte("\x00\x00\x00\x18ftypF4V \x00\x00\x02\x00"),
+		"video/mj2":                        []byte("\x00\x00\x00\x18ftypmjp2\x00\x00\x02\x00"),
+		"video/jpm":                        []byte("\x00\x00\x00\x18ftypjpm \x00\x00\x02\x00"),
+		"audio/ogg":                        append(append([]byte("OggS"), make([]byte, 24)...), "\x01vorbis"...),
+		"video/ogg":                        append(append([]byte("OggS"), make([]byte, 24)...), "\x80theora"...),
+		"video/webm":                       []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x84webm"),
+		"video/x-matroska":                 []byte("\x1A\x45\xDF\xA3\x9F\x42\x86\x81\x01\x42\x82\x88matroska"),
+		"image/x-pcx":                      []byte("\x0A\x05\x01\x08\x00\x00\x00\x00"),
+		"application/java-archive":         newTestZip(t, "META-INF/MANIFEST.MF", "Manifest-Version: 1.0\n"),
+		"application/x-xpinstall":          newTestZip(t, "install.rdf", "<RDF/>"),
+		"application/x-silverlight-app":    newTestZip(t, "AppManifest.xaml", "<Deployment/>"),
+		"application/vnd.google-earth.kmz": newTestZip(t, "doc.kml", "<kml/>"),
+		"application/vnd.android.package-archive": newTestZip(t,
+			"AndroidManifest.xml", "\x03\x00\x08\x00", "classes.dex", "dex\n035\x00"),
+	}
+
+	for _, mimeType := range containerMimeTypes {
+		if mimeType != "application/epub+zip" && !strings.HasSuffix(mimeType, "air-application-installer-package+zip") &&
+			!strings.HasPrefix(mimeType, "application/vnd.oasis.opendocument.") {
+			continue
+		}
+		samples[mimeType] = newTestZip(t, "mimetype", mimeType, "content.xml", "<document/>")
+	}
+	for contentType, mimeType := range ooxmlMainContentTypes {
+		samples[mimeType] = newTestZip(t, "[Content_Types].xml", fmt.Sprintf(
+			`<?xml version="1.0"?><Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">`+
+				`<Default Extension="xml" ContentType="application/xml"/><Override PartName="/main.xml" ContentType="%s"/></Types>`,
+			contentType))
+	}
+	for name, mimeType := range oleStreamMimeTypes {
+		samples[mimeType] = newTestOLE(name)
+	}
+	for root, mimeType := range xmlRootMimeTypes {
+		if _, ok := samples[mimeType]; !ok {
+			samples[mimeType] = []byte(fmt.Sprintf("<?xml version=\"1.0\"?>\n<!-- sample -->\n<%s></%s>", root, root))
+		}
+	}
+
+	for _, signature := range builtinSignatures {
+		if _, ok := samples[signature.MimeType]; ok || signature.Match != nil || signature.Optional {
+			continue
+		}
+		sample := make([]byte, signature.Offset, signature.Offset+len(signature.Magic)+16)
+		sample = append(sample, signature.Magic...)
+		samples[signature.MimeType] = append(sample, make([]byte, 16)...)
+	}
+
+	return samples
+}
+
+// genericSample returns content without a signature of its own for mimeType.
+func genericSample(t *testing.T, mimeType string) []byte {
+	switch genericMimeTypes(mimeType)[0] {
+	case mimeZip:
+		return newTestZip(t, "content.txt", "content")
+	case mimeOLEStorage:
+		return newTestOLE("Contents")
+	case mimeTextPlain:
+		return []byte("sample, text\n")
+	}
+	return []byte{0x00, 0x01, 0x02, 0x03, 0xFE, 0xFD}
+}
+
+func TestSnifferDetect(t *testing.T) {
+	samples := testSamples(t)
+	mimeTypes := make([]string, 0, len(samples))
+	for mimeType := range samples {
+		mimeTypes = append(mimeTypes, mimeType)
+	}
+	sort.Strings(mimeTypes)
+
+	for _, mimeType := range mimeTypes {
+		if actual := DefaultSniffer.Detect(samples[mimeType]); actual != mimeType {
+			t.Errorf("Expected Detect to return %s, got %s", mimeType, actual)
+		}
+	}
+
+	var tests = []struct {
+		data     []byte
+		expected string
+	}{
+		{[]byte(""), "text/plain"},
+		{[]byte("plain text"), "text/plain"},
+		{[]byte("\xEF\xBB\xBF  <svg xmlns=\"http://www.w3.org/2000/svg\"/>"), "image/svg+xml"},
+		{[]byte("<?xml version=\"1.0\"?>\n<!DOCTYPE svg PUBLIC \"-//W3C//DTD SVG 1.1//EN\" \"svg11.dtd\">\n<svg/>"), "image/svg+xml"},
+		{[]byte("<?xml version=\"1.0\"?><note><to>Tove</to></note>"), "application/xml"},
+		{[]byte("<div>fragment</div>"), "text/html"},
+		{[]byte("[1, 2, 3]"), "application/json"},
+		{[]byte("{not json}"), "text/plain"},
+		{newTestZip(t, "readme.txt", "text"), "application/zip"},
+		{newTestOLE("Contents"), "application/x-ole-storage"},
+		{[]byte{0x00, 0x01, 0x02, 0x03}, "application/octet-stream"},
+	}
+	for i, test := range tests {
+		if actual := DefaultSniffer.Detect(test.data); actual != test.expected {
+			t.Errorf("Case %d: expected Detect to return %s, got %s", i, test.expected, actual)
+		}
+	}
+}
+
+func TestSnifferSpoofedZipMimetype(t *testing.T) {
+	spoofed := newTestZip(t, "mimetype", "image/png", "evil.html", "<script>alert(1)</script>")
+	if actual := DefaultSniffer.Detect(spoofed); actual != "application/zip" {
+		t.Errorf("Expected a mimetype entry naming a non-container type to be ignored, got %s", actual)
+	}
+
+	type Upload struct {
+		Avatar *multipart.FileHeader `valid:"mimes=png"`
+		Photo  *multipart.FileHeader `valid:"image"`
+	}
+	headers := newTestFileHeaders(t, map[string][]byte{"evil.png": spoofed})
+	err := ValidateStruct(Upload{Avatar: headers[0], Photo: headers[0]})
+	if err == nil || len(err.(Errors)) != 2 {
+		t.Errorf("Expected the spoofed archive to fail mimes and image, got %v", err)
+	}
+}
+
+func TestSnifferRegister(t *testing.T) {
+	sniffer := NewSniffer()
+	data := []byte("MYFMT\x00\x01\x02")
+
+	if sniffer.Detect(data) != "application/octet-stream" {
+		t.Fatalf("Expected unknown format before registering, got %s", sniffer.Detect(data))
+	}
+	sniffer.Register(Signature{MimeType: "application/x-my-format", Magic: []byte("MYFMT")})
+	if actual := sniffer.Detect(data); actual != "application/x-my-format" {
+		t.Errorf("Expected registered signature to match, got %s", actual)
+	}
+	if !sniffer.isStrict("application/x-my-format") {
+		t.Error("Expected registered signature to make its type strict")
+	}
+
+	sniffer.Register(Signature{MimeType: "image/x-custom-png", Match: func(header []byte) bool {
+		return bytes.HasPrefix(header, []byte("\x89PNG"))
+	}})
+	if actual := sniffer.Detect(testPNG); actual != "image/x-custom-png" {
+		t.Errorf("Expected registered signature to take precedence, got %s", actual)
+	}
+	if actual := DefaultSniffer.Detect(testPNG); actual != "image/png" {
+		t.Errorf("Expected DefaultSniffer to be unaffected, got %s", actual)
+	}
+}
+
+func TestMimesEveryExtension(t *testing.T) {
+	samples := testSamples(t)
+
+	extensions := make([]string, 0, len(Mimes))
+	for extension := range Mimes {
+		extensions = append(extensions, extension)
+	}
+	sort.Strings(extensions)
+
+	files := make(map[string][]byte, 2*len(extensions))
+	for _, extension := range extensions {
+		mimeType := Mimes[extension]
+		sample, ok := samples[mimeType]
+		if !ok {
+			found := false
+			for _, compatible := range compatibleMimeTypes[mimeType] {
+				if sample, found = samples[compatible]; found {
+					break
+				}
+			}
+			if !found {
+				if DefaultSniffer.isStrict(mimeType) {
+					t.Errorf("No sample for %s", mimeType)
+					continue
+				}
+				sample = genericSample(t, mimeType)
+			}
+		}
+		files["file."+extension] = sample
+		files["png."+extension] = testPNG
+	}
+
+	// multipart.Reader limits the number of parts in a form, so the headers are built in batches.
+	var headers []*multipart.FileHeader
+	batch := make(map[string][]byte)
+	for name, content := range files {
+		batch[name] = content
+		if len(batch) == 500 {
+			headers = append(headers, newTestFileHeaders(t, batch)...)
+			batch = make(map[string][]byte)
+		}
+	}
+	headers = append(headers, newTestFileHeaders(t, batch)...)
+	if len(headers) != len(files) {
+		t.Fatalf("Expected %d file headers, got %d", len(files), len(headers))
+	}
+
+	for _, header := range headers {
+		extension := header.Filename[len("file."):]
+		if header.Filename[:4] == "png." {
+			extension = header.Filename[len("png."):]
+		}
+		valid, err := validateMimes(reflect.ValueOf(header), []string{extension})
+		if err != nil {
+			t.Errorf("Unexpected error for %s: %v", header.Filename, err)
+			continue
+		}
+		expected := header.Filename[:4] == "file" || Mimes[extension] == "image/png"
+		if valid != expected {
+			t.Errorf("Expected mimes=%s for %s to be %v, got %v", extension, header.Filename, expected, valid)
+		}
+	}
+}
+
+func TestMimesGenericContent(t *testing.T) {
+	headers := newTestFileHeaders(t, map[string][]byte{
+		"data.csv":  []byte("a,b\n1,2\n"),
+		"data.exe":  []byte("a,b\n1,2\n"),
+		"data.html": []byte("<html><body>a,b</body></html>"),
+		"image.png": []byte("not an image"),
+	})
+
+	var tests = []struct {
+		index    int
+		mimes    []string
+		expected bool
+	}{
+		{0, []string{"csv"}, true},
+		{1, []string{"csv"}, false},
+		{2, []string{"csv"}, false},
+		{2, []string{"html"}, true},
+		{3, []string{"png"}, false},
+	}
+	for _, test := range tests {
+		valid, err := validateMimes(reflect.ValueOf(headers[test.index]), test.mimes)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if valid != test.expected {
+			t.Errorf("Expected mimes=%v for %s to be %v, got %v", test.mimes, headers[test.index].Filename, test.expected, valid)
+		}
+	}
+
+	if valid, _ := ValidateMimes([]byte("a,b\n1,2\n"), []string{"csv"}); !valid {
+		t.Error("Expected text content without a file name to match csv")
+	}
+	if valid, _ := ValidateMimes(testPNG, []string{"csv"}); valid {
+		t.Error("Expected png content not to match csv")
+	}
+}
@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -73,3 +75,62 @@ func (t *Translator) Trans(errors Errors, language string) Errors {
 
 	return errors
 }
+
+// Match returns the language of the translator that best matches the languages, an Accept-Language header value such
+// as "zh-HK,zh;q=0.9,en;q=0.8" or a single language code, or "" when none of them has messages. Tags match language
+// codes written with either a hyphen or an underscore, and fall back to their primary language.
+func (t *Translator) Match(languages string) string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(languages, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		q := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			if f, err := strconv.ParseFloat(params[2:], 64); err == nil {
+				q = f
+			}
+		}
+		if tag = strings.TrimSpace(tag); tag != "" && tag != "*" && q > 0 {
+			tags = append(tags, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	for _, w := range tags {
+		if code := t.matchTag(w.tag); code != "" {
+			return code
+		}
+	}
+	return ""
+}
+
+// matchTag returns the language code with messages for the tag, compared case-insensitively with hyphens and
+// underscores alike, then for its primary language or the first code in that language.
+func (t *Translator) matchTag(tag string) string {
+	normalize := func(s string) string { return strings.ToLower(strings.ReplaceAll(s, "_", "-")) }
+	tag = normalize(tag)
+	primary, _, _ := strings.Cut(tag, "-")
+	codes := make([]string, 0, len(t.messages))
+	for code := range t.messages {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var fallback string
+	for _, code := range codes {
+		switch normalize(code) {
+		case tag:
+			return code
+		case primary:
+			fallback = code
+		default:
+			if fallback == "" && strings.HasPrefix(normalize(code), primary+"-") {
+				fallback = code
+			}
+		}
+	}
+	return fallback
+}
@@ -191,3 +191,29 @@ func TestTransWithNonFieldError(t *testing.T) {
 		t.Error("Expected one error")
 	}
 }
+
+func TestTranslatorMatch(t *testing.T) {
+	translator := NewTranslator()
+	translator.SetMessage("en", Translate{})
+	translator.SetMessage("zh_CN", Translate{})
+	translator.SetMessage("zh_HK", Translate{})
+
+	var tests = []struct {
+		languages string
+		expected  string
+	}{
+		{"zh-HK", "zh_HK"},
+		{"zh_cn", "zh_CN"},
+		{"fr, zh-TW;q=0.5, en;q=0.8", "en"},
+		{"en-GB", "en"},
+		{"zh", "zh_CN"},
+		{"en;q=0, zh-HK;q=0.1", "zh_HK"},
+		{"fr, *", ""},
+		{"", ""},
+	}
+	for _, test := range tests {
+		if actual := translator.Match(test.languages); actual != test.expected {
+			t.Errorf("Expected Match(%q) to be %q, got %q", test.languages, test.expected, actual)
+		}
+	}
+}
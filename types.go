@@ -55,20 +55,52 @@ func (tm *customTypeRuleMap) Set(name string, ctv CustomTypeValidateFunc) {
 
 // RuleMap is a map of functions, that can be used as tags for ValidateStruct function.
 var RuleMap = map[string]ValidateFunc{
-	"distinct": validateDistinct,
+	"distinct":       validateDistinct,
+	"accepted":       validateAccepted,
+	"boolean":        validateBoolean,
+	"filled":         validateFilled,
+	"string":         validateString,
+	"file":           validateFile,
+	"image":          validateImage,
+	"port":           validatePort,
+	"countryNumeric": validateCountryNumeric,
+	"positive":       validatePositive,
+	"negative":       validateNegative,
+	"nonZero":        validateNonZero,
 }
 
 // ParamRuleMap is a map of functions, that can be used as tags for ValidateStruct function.
 var ParamRuleMap = map[string]ParamValidateFunc{
-	"between":       validateBetween,
-	"digitsBetween": validateDigitsBetween,
-	"min":           validateMin,
-	"max":           validateMax,
-	"size":          validateSize,
-	"gt":            validateGtParam,
-	"gte":           validateGteParam,
-	"lt":            validateLtParam,
-	"lte":           validateLteParam,
+	"between":        validateBetween,
+	"digitsBetween":  validateDigitsBetween,
+	"min":            validateMin,
+	"max":            validateMax,
+	"size":           validateSize,
+	"gt":             validateGtParam,
+	"gte":            validateGteParam,
+	"lt":             validateLtParam,
+	"lte":            validateLteParam,
+	"digits":         validateDigits,
+	"mimes":          validateMimes,
+	"mimetypes":      validateMimeTypes,
+	"dimensions":     validateDimensions,
+	"creditCard":     validateCreditCard,
+	"ipIn":           validateIPIn,
+	"ipNotIn":        validateIPNotIn,
+	"url":            validateURL,
+	"urlHost":        validateURLHost,
+	"uuid1":          validateUUID1,
+	"uuid6":          validateUUID6,
+	"uuid7":          validateUUID7,
+	"uuidAny":        validateUUIDAny,
+	"semverRange":    validateSemverRange,
+	"currencyAmount": validateCurrencyAmount,
+	"phone":          validatePhone,
+	"postcode":       validatePostcode,
+	"decimal":        validateDecimal,
+	"multipleOf":     validateMultipleOf,
+	"in":             validateIn,
+	"notIn":          validateNotIn,
 }
 
 // StringRulesMap is a map of functions, that can be used as tags for ValidateStruct function when reflect type is string.
@@ -87,11 +119,54 @@ var StringRulesMap = map[string]StringValidateFunc{
 	"ip":               ValidateIP,
 	"ipv4":             ValidateIPv4,
 	"ipv6":             ValidateIPv6,
+	"cidr":             ValidateCIDR,
+	"cidrv4":           ValidateCIDRv4,
+	"cidrv6":           ValidateCIDRv6,
+	"mac":              ValidateMAC,
+	"hostname":         ValidateHostname,
+	"fqdn":             ValidateFQDN,
+	"hostPort":         ValidateHostPort,
+	"unixAddr":         ValidateUnixAddr,
+	"publicIp":         ValidatePublicIP,
+	"privateIp":        ValidatePrivateIP,
+	"loopback":         ValidateLoopback,
 	"uuid3":            ValidateUUID3,
 	"uuid4":            ValidateUUID4,
 	"uuid5":            ValidateUUID5,
 	"uuid":             ValidateUUID,
-	"url":              ValidateURL,
+	"ulid":             ValidateULID,
+	"semver":           ValidateSemver,
+	"base64":           ValidateBase64,
+	"base64url":        ValidateBase64URL,
+	"base64RawUrl":     ValidateBase64RawURL,
+	"jwt":              ValidateJWT,
+	"iban":             ValidateIBAN,
+	"bic":              ValidateBIC,
+	"currency":         ValidateCurrency,
+	"country2":         ValidateCountry2,
+	"country3":         ValidateCountry3,
+	"language":         ValidateLanguage,
+	"e164":             ValidateE164,
+	"cnResidentId":     ValidateCNResidentID,
+	"hkid":             ValidateHKID,
+	"cnCreditCode":     ValidateCNSocialCreditCode,
+	"cnMobile":         ValidateCNMobile,
+	"cnLandline":       ValidateCNLandline,
+	"urlRequireScheme": ValidateURLRequireScheme,
+	"httpUrl":          ValidateHTTPURL,
+	"safeUrl":          ValidateSafeURL,
+	"json":             ValidateJSON,
+	"timezone":         ValidateTimezone,
+	"isbn":             ValidateISBN,
+	"isbn10":           ValidateISBN10,
+	"isbn13":           ValidateISBN13,
+	"hexadecimal":      ValidateHexadecimal,
+	"hexColor":         ValidateHexColor,
+	"rgb":              ValidateRGB,
+	"rgba":             ValidateRGBA,
+	"hsl":              ValidateHSL,
+	"hsla":             ValidateHSLA,
+	"color":            ValidateColor,
 }
 
 // Mimes is a map of extension to MIME types.
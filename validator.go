@@ -2,13 +2,13 @@ package validator
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
-	"net/http"
+	"math/big"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
-	"unicode/utf8"
 )
 
 const tagName string = "valid"
@@ -18,6 +18,19 @@ type Validator struct {
 	Attributes    map[string]string
 	CustomMessage map[string]string
 	Translator    *Translator
+	// LengthMode is the unit in which the length rules measure strings whose tags do not select one.
+	LengthMode LengthMode
+	// PathFormat formats the paths of fields as the names of their errors, such as BracketPath or JSONPointerPath.
+	// Names are dot-joined when it is nil.
+	PathFormat PathFormatter
+	// ErrorEncoder selects the format into which the errors it returns marshal, such as KeyedErrors, JSONAPIErrors
+	// or GraphQLErrors.
+	ErrorEncoder ErrorEncoder
+
+	passwordPolicies map[string]*PasswordPolicy
+	typeFuncs        map[reflect.Type]CustomTypeFunc
+	emptyFuncs       map[reflect.Type]EmptyFunc
+	modifiers        map[string]ModifierFunc
 }
 
 // Default returns a instance of Validator
@@ -39,17 +52,21 @@ func validateBetween(v reflect.Value, params []string) (bool, error) {
 	var valid bool
 	var err error
 
-	switch v.Kind() {
-	case reflect.String:
-		minVal, err := ToInt(params[0])
+	if _, ok := bigNumber(v); ok {
+		valid, err = numberBetween(v, params[0], params[1])
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on string field, min value: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field: %w", err)
 		}
-		maxVal, err := ToInt(params[1])
+		return valid, nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		lengths, mode, err := parseLengthParams(params...)
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on string field, max value: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Between rule on string field: %w", err)
 		}
-		valid = ValidateBetweenString(v.String(), minVal, maxVal)
+		valid = ValidateDigitsBetweenInt64(int64(StringLength(v.String(), mode)), lengths[0], lengths[1])
 	case reflect.Slice, reflect.Map, reflect.Array:
 		minVal, err := ToInt(params[0])
 		if err != nil {
@@ -60,36 +77,13 @@ func validateBetween(v reflect.Value, params []string) (bool, error) {
 			return false, fmt.Errorf("validator: invalid parameter for Between rule on collection field, max value: %w", err)
 		}
 		valid = ValidateDigitsBetweenInt64(int64(v.Len()), minVal, maxVal)
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		minVal, err := ToInt(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, min value: %w", err)
-		}
-		maxVal, err := ToInt(params[1])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, max value: %w", err)
-		}
-		valid = ValidateDigitsBetweenInt64(v.Int(), minVal, maxVal)
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		minVal, err := ToUint(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, min value: %w", err)
-		}
-		maxVal, err := ToUint(params[1])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, max value: %w", err)
-		}
-		valid = ValidateDigitsBetweenUint64(v.Uint(), minVal, maxVal)
-	case reflect.Float32, reflect.Float64:
-		minVal, err := ToFloat(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, min value: %w", err)
-		}
-		maxVal, err := ToFloat(params[1])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = numberBetween(v, params[0], params[1])
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field, max value: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Between rule on numeric field: %w", err)
 		}
-		valid = ValidateDigitsBetweenFloat64(v.Float(), minVal, maxVal)
 	default:
 		return false, fmt.Errorf("validator: Between unsupported type %T", v.Interface())
 	}
@@ -131,19 +125,49 @@ func (v *Validator) validateWithRuleMap(tag *ValidTag, value reflect.Value, f *f
 // validateWithParamRuleMap validates a value using ParamRuleMap and returns formatted error if validation fails
 func (v *Validator) validateWithParamRuleMap(tag *ValidTag, value reflect.Value, f *field, name, structName string, o reflect.Value) error {
 	if validfunc, ok := ParamRuleMap[tag.name]; ok {
+		str := ToString(value.Interface())
+		if tag.numeric && value.Kind() == reflect.String {
+			number, ok := parseDecimal(value.String())
+			if ok {
+				value = reflect.ValueOf(number)
+			} else if f, err := strconv.ParseFloat(value.String(), 64); err == nil || errors.Is(err, strconv.ErrRange) {
+				// Exponents beyond big.Rat are compared as infinities, or zero.
+				value = reflect.ValueOf(f)
+			} else {
+				// Strings that are not numbers are reported by the decimal rule.
+				return nil
+			}
+		}
+		tag = v.lengthTag(tag, value)
 		isValid, funcError := validfunc(value, tag.params)
 		if !isValid {
-			return v.formatsMessages(v.createFieldError(
+			fieldError := v.createFieldError(
 				name, structName, tag.name, tag.messageName,
 				parseValidatorMessageParameters(tag, o),
 				f.attribute, f.defaultAttribute,
-				ToString(value.Interface()), funcError,
-			))
+				str, funcError,
+			)
+			if funcError == nil {
+				fieldError.detail = ruleDetail(tag, value, str)
+			}
+			return v.formatsMessages(fieldError)
 		}
 	}
 	return nil
 }
 
+// lengthTag returns the tag of a length rule on a string measured in the LengthMode of the Validator, unless the tag
+// selects a mode itself.
+func (v *Validator) lengthTag(tag *ValidTag, value reflect.Value) *ValidTag {
+	if v.LengthMode == LengthRunes || !lengthRules[tag.name] || value.Kind() != reflect.String || hasLengthMode(tag.params) {
+		return tag
+	}
+	moded := *tag
+	moded.params = withLengthMode(tag.params, v.LengthMode)
+	moded.messageName = lengthMessageName(tag.messageName, v.LengthMode)
+	return &moded
+}
+
 // validateWithStringRulesMap validates a string value using StringRulesMap and returns formatted error if validation fails
 func (v *Validator) validateWithStringRulesMap(tag *ValidTag, value reflect.Value, f *field, name, structName string, o reflect.Value) error {
 	if validfunc, ok := StringRulesMap[tag.name]; ok {
@@ -228,9 +252,9 @@ func extractValuesFromCollection(field reflect.Value) ([]string, error) {
 }
 
 // checkRequiredIfCondition checks if the required condition is met and updates tag parameters
-func checkRequiredIfCondition(v reflect.Value, values, params []string, tag *ValidTag) (bool, error) {
+func checkRequiredIfCondition(empty EmptyFunc, v reflect.Value, values, params []string, tag *ValidTag) (bool, error) {
 	for _, value := range values {
-		if InString(value, params) && Empty(v) {
+		if InString(value, params) && empty(v) {
 			if tag != nil {
 				tag.messageParameters = append(
 					tag.messageParameters,
@@ -283,8 +307,9 @@ func (v *Validator) validateMapFields(value reflect.Value, f *field, jsonNamespa
 			newJSONNamespace = append(append(newJSONNamespace, []byte(k.String())...), '.')
 			newstructNamespace := append(append(structNamespace, f.structNameBytes...), '.')
 			newstructNamespace = append(append(newstructNamespace, []byte(k.String())...), '.')
-			err = v.ValidateStruct(item.Interface(), newJSONNamespace, newstructNamespace)
+			err = v.validateStruct(item.Interface(), newJSONNamespace, newstructNamespace)
 			if err != nil {
+				prependPath(err, PathSegment{Kind: KeySegment, Name: k.String()})
 				return err
 			}
 		}
@@ -306,8 +331,9 @@ func (v *Validator) validateSliceFields(value reflect.Value, f *field, jsonNames
 			newJSONNamespace = append(append(newJSONNamespace, []byte(strconv.Itoa(i))...), '.')
 			newStructNamespace := append(append(structNamespace, f.structNameBytes...), '.')
 			newStructNamespace = append(append(newStructNamespace, []byte(strconv.Itoa(i))...), '.')
-			err = v.ValidateStruct(value.Index(i).Interface(), newJSONNamespace, newStructNamespace)
+			err = v.validateStruct(value.Index(i).Interface(), newJSONNamespace, newStructNamespace)
 			if err != nil {
+				prependPath(err, PathSegment{Kind: IndexSegment, Index: i})
 				return err
 			}
 		}
@@ -370,13 +396,21 @@ func ValidateDigitsBetween(i interface{}, params []string) (bool, error) {
 func validateSize(v reflect.Value, param []string) (bool, error) {
 	valid := false
 	var err error
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, param[0], "==")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(param[0])
+		lengths, mode, err := parseLengthParams(param[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Size rule on string field, value: %w", err)
 		}
-		valid, err = compareString(v.String(), p, "==")
+		valid, err = compareStringLength(v.String(), lengths[0], "==", mode)
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Size rule on string field, value: %w", err)
 		}
@@ -389,30 +423,10 @@ func validateSize(v reflect.Value, param []string) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Size rule on collection field, value: %w", err)
 		}
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, "==")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
-		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, "==")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
-		}
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
-		}
-		valid, err = compareFloat64(v.Float(), p, "==")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, param[0], "==")
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Size rule on numeric field, value: %w", err)
 		}
@@ -439,13 +453,21 @@ func validateMax(v reflect.Value, param []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, param[0], "<=")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(param[0])
+		lengths, mode, err := parseLengthParams(param[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Max rule on string field, value: %w", err)
 		}
-		valid, err = compareString(v.String(), p, "<=")
+		valid, err = compareStringLength(v.String(), lengths[0], "<=", mode)
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Max rule on string field, value: %w", err)
 		}
@@ -458,30 +480,10 @@ func validateMax(v reflect.Value, param []string) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Max rule on collection field, value: %w", err)
 		}
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, "<=")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
-		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, "<=")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
-		}
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
-		}
-		valid, err = compareFloat64(v.Float(), p, "<=")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, param[0], "<=")
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Max rule on numeric field, value: %w", err)
 		}
@@ -503,13 +505,21 @@ func validateMin(v reflect.Value, param []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, param[0], ">=")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(param[0])
+		lengths, mode, err := parseLengthParams(param[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Min rule on string field, value: %w", err)
 		}
-		valid, err = compareString(v.String(), p, ">=")
+		valid, err = compareStringLength(v.String(), lengths[0], ">=", mode)
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Min rule on string field, value: %w", err)
 		}
@@ -522,30 +532,10 @@ func validateMin(v reflect.Value, param []string) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Min rule on collection field, value: %w", err)
 		}
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, ">=")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
-		}
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, ">=")
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
-		}
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(param[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
-		}
-		valid, err = compareFloat64(v.Float(), p, ">=")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, param[0], ">=")
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Min rule on numeric field, value: %w", err)
 		}
@@ -571,13 +561,21 @@ func validateGtParam(v reflect.Value, params []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, params[0], ">")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Gt rule on numeric field: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(params[0])
+		lengths, mode, err := parseLengthParams(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Gt rule on string field: %w", err)
 		}
-		valid, err = compareString(v.String(), p, ">")
+		valid, err = compareStringLength(v.String(), lengths[0], ">", mode)
 		if err != nil {
 			return false, fmt.Errorf("validator: comparison error for Gt rule on string field: %w", err)
 		}
@@ -587,24 +585,13 @@ func validateGtParam(v reflect.Value, params []string) (bool, error) {
 			return false, fmt.Errorf("validator: invalid parameter for Gt rule on collection field: %w", err)
 		}
 		valid, err = compareInt64(int64(v.Len()), p, ">")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gt rule on int field: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, ">")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gt rule on uint field: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, ">")
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(params[0])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, params[0], ">")
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gt rule on float field: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Gt rule on numeric field: %w", err)
 		}
-		valid, err = compareFloat64(v.Float(), p, ">")
 	default:
 		return false, fmt.Errorf("validator: Gt rule is not supported for type %s", v.Kind())
 	}
@@ -627,37 +614,34 @@ func validateGteParam(v reflect.Value, params []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, params[0], ">=")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Gte rule on numeric field: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(params[0])
+		lengths, mode, err := parseLengthParams(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Gte rule on string field: %w", err)
 		}
-		valid, err = compareString(v.String(), p, ">=")
+		valid, err = compareStringLength(v.String(), lengths[0], ">=", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		p, err := ToInt(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Gte rule on collection field: %w", err)
 		}
 		valid, err = compareInt64(int64(v.Len()), p, ">=")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gte rule on int field: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, ">=")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gte rule on uint field: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, ">=")
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(params[0])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, params[0], ">=")
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Gte rule on float field: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Gte rule on numeric field: %w", err)
 		}
-		valid, err = compareFloat64(v.Float(), p, ">=")
 	default:
 		return false, fmt.Errorf("validator: Gte rule is not supported for type %s", v.Kind())
 	}
@@ -680,37 +664,34 @@ func validateLtParam(v reflect.Value, params []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, params[0], "<")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Lt rule on numeric field: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(params[0])
+		lengths, mode, err := parseLengthParams(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Lt rule on string field: %w", err)
 		}
-		valid, err = compareString(v.String(), p, "<")
+		valid, err = compareStringLength(v.String(), lengths[0], "<", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		p, err := ToInt(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Lt rule on collection field: %w", err)
 		}
 		valid, err = compareInt64(int64(v.Len()), p, "<")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lt rule on int field: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, "<")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lt rule on uint field: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, "<")
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(params[0])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, params[0], "<")
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lt rule on float field: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Lt rule on numeric field: %w", err)
 		}
-		valid, err = compareFloat64(v.Float(), p, "<")
 	default:
 		return false, fmt.Errorf("validator: Lt rule is not supported for type %s", v.Kind())
 	}
@@ -733,37 +714,34 @@ func validateLteParam(v reflect.Value, params []string) (bool, error) {
 	var valid bool
 	var err error
 
+	if _, ok := bigNumber(v); ok {
+		valid, err = compareNumberParam(v, params[0], "<=")
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Lte rule on numeric field: %w", err)
+		}
+		return valid, nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
-		p, err := ToInt(params[0])
+		lengths, mode, err := parseLengthParams(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Lte rule on string field: %w", err)
 		}
-		valid, err = compareString(v.String(), p, "<=")
+		valid, err = compareStringLength(v.String(), lengths[0], "<=", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		p, err := ToInt(params[0])
 		if err != nil {
 			return false, fmt.Errorf("validator: invalid parameter for Lte rule on collection field: %w", err)
 		}
 		valid, err = compareInt64(int64(v.Len()), p, "<=")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		p, err := ToInt(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lte rule on int field: %w", err)
-		}
-		valid, err = compareInt64(v.Int(), p, "<=")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		p, err := ToUint(params[0])
-		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lte rule on uint field: %w", err)
-		}
-		valid, err = compareUint64(v.Uint(), p, "<=")
-	case reflect.Float32, reflect.Float64:
-		p, err := ToFloat(params[0])
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumberParam(v, params[0], "<=")
 		if err != nil {
-			return false, fmt.Errorf("validator: invalid parameter for Lte rule on float field: %w", err)
+			return false, fmt.Errorf("validator: invalid parameter for Lte rule on numeric field: %w", err)
 		}
-		valid, err = compareFloat64(v.Float(), p, "<=")
 	default:
 		return false, fmt.Errorf("validator: Lte rule is not supported for type %s", v.Kind())
 	}
@@ -782,7 +760,7 @@ func validateSame(v, anotherField reflect.Value) (bool, error) {
 	if !v.IsValid() || !anotherField.IsValid() {
 		return false, fmt.Errorf("validator: Same invalid reflection values")
 	}
-	if v.Kind() != anotherField.Kind() {
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
 		return false, fmt.Errorf("validator: Same The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
 	}
 
@@ -794,12 +772,10 @@ func validateSame(v, anotherField reflect.Value) (bool, error) {
 		valid, err = v.String() == anotherField.String(), nil
 	case reflect.Slice, reflect.Map, reflect.Array:
 		valid, err = compareInt64(int64(v.Len()), int64(anotherField.Len()), "==")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		valid, err = compareInt64(v.Int(), anotherField.Int(), "==")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		valid, err = compareUint64(v.Uint(), anotherField.Uint(), "==")
-	case reflect.Float32, reflect.Float64:
-		valid, err = compareFloat64(v.Float(), anotherField.Float(), "==")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumbers(v, anotherField, "==")
 	default:
 		return false, fmt.Errorf("validator: Same unsupported type %T", v.Interface())
 	}
@@ -815,11 +791,11 @@ func ValidateSame(i, a interface{}) (bool, error) {
 }
 
 // validateLt is the validation function for validating if the current field's value is less than the param's value.
-func validateLt(v, anotherField reflect.Value) (bool, error) {
+func validateLt(v, anotherField reflect.Value, mode LengthMode) (bool, error) {
 	if !v.IsValid() || !anotherField.IsValid() {
 		return false, fmt.Errorf("validator: Lt invalid reflection values")
 	}
-	if v.Kind() != anotherField.Kind() {
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
 		return false, fmt.Errorf("validator: Lt The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
 	}
 
@@ -828,15 +804,13 @@ func validateLt(v, anotherField reflect.Value) (bool, error) {
 
 	switch v.Kind() {
 	case reflect.String:
-		valid, err = compareString(v.String(), int64(utf8.RuneCountInString(anotherField.String())), "<")
+		valid, err = compareStringLength(v.String(), int64(StringLength(anotherField.String(), mode)), "<", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		valid, err = compareInt64(int64(v.Len()), int64(anotherField.Len()), "<")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		valid, err = compareInt64(v.Int(), anotherField.Int(), "<")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		valid, err = compareUint64(v.Uint(), anotherField.Uint(), "<")
-	case reflect.Float32, reflect.Float64:
-		valid, err = compareFloat64(v.Float(), anotherField.Float(), "<")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumbers(v, anotherField, "<")
 	default:
 		return false, fmt.Errorf("validator: Lt unsupported type %T", v.Interface())
 	}
@@ -848,15 +822,15 @@ func validateLt(v, anotherField reflect.Value) (bool, error) {
 func ValidateLt(i, a interface{}) (bool, error) {
 	v := reflect.ValueOf(i)
 	anotherField := reflect.ValueOf(a)
-	return validateLt(v, anotherField)
+	return validateLt(v, anotherField, LengthRunes)
 }
 
 // validateLte is the validation function for validating if the current field's value is less than or equal to the param's value.
-func validateLte(v, anotherField reflect.Value) (bool, error) {
+func validateLte(v, anotherField reflect.Value, mode LengthMode) (bool, error) {
 	if !v.IsValid() || !anotherField.IsValid() {
 		return false, fmt.Errorf("validator: Lte invalid reflection values")
 	}
-	if v.Kind() != anotherField.Kind() {
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
 		return false, fmt.Errorf("validator: Lte The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
 	}
 
@@ -865,15 +839,13 @@ func validateLte(v, anotherField reflect.Value) (bool, error) {
 
 	switch v.Kind() {
 	case reflect.String:
-		valid, err = compareString(v.String(), int64(utf8.RuneCountInString(anotherField.String())), "<=")
+		valid, err = compareStringLength(v.String(), int64(StringLength(anotherField.String(), mode)), "<=", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		valid, err = compareInt64(int64(v.Len()), int64(anotherField.Len()), "<=")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		valid, err = compareInt64(v.Int(), anotherField.Int(), "<=")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		valid, err = compareUint64(v.Uint(), anotherField.Uint(), "<=")
-	case reflect.Float32, reflect.Float64:
-		valid, err = compareFloat64(v.Float(), anotherField.Float(), "<=")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumbers(v, anotherField, "<=")
 	default:
 		return false, fmt.Errorf("validator: Lte unsupported type %T", v.Interface())
 	}
@@ -885,15 +857,15 @@ func validateLte(v, anotherField reflect.Value) (bool, error) {
 func ValidateLte(i, a interface{}) (bool, error) {
 	v := reflect.ValueOf(i)
 	anotherField := reflect.ValueOf(a)
-	return validateLte(v, anotherField)
+	return validateLte(v, anotherField, LengthRunes)
 }
 
 // validateGt is the validation function for validating if the current field's value is greater than to the param's value.
-func validateGt(v, anotherField reflect.Value) (bool, error) {
+func validateGt(v, anotherField reflect.Value, mode LengthMode) (bool, error) {
 	if !v.IsValid() || !anotherField.IsValid() {
 		return false, fmt.Errorf("validator: Gt invalid reflection values")
 	}
-	if v.Kind() != anotherField.Kind() {
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
 		return false, fmt.Errorf("validator: Gt The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
 	}
 
@@ -902,15 +874,13 @@ func validateGt(v, anotherField reflect.Value) (bool, error) {
 
 	switch v.Kind() {
 	case reflect.String:
-		valid, err = compareString(v.String(), int64(utf8.RuneCountInString(anotherField.String())), ">")
+		valid, err = compareStringLength(v.String(), int64(StringLength(anotherField.String(), mode)), ">", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		valid, err = compareInt64(int64(v.Len()), int64(anotherField.Len()), ">")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		valid, err = compareInt64(v.Int(), anotherField.Int(), ">")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		valid, err = compareUint64(v.Uint(), anotherField.Uint(), ">")
-	case reflect.Float32, reflect.Float64:
-		valid, err = compareFloat64(v.Float(), anotherField.Float(), ">")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumbers(v, anotherField, ">")
 	default:
 		return false, fmt.Errorf("validator: Gt unsupported type %T", v.Interface())
 	}
@@ -922,15 +892,15 @@ func validateGt(v, anotherField reflect.Value) (bool, error) {
 func ValidateGt(i, a interface{}) (bool, error) {
 	v := reflect.ValueOf(i)
 	anotherField := reflect.ValueOf(a)
-	return validateGt(v, anotherField)
+	return validateGt(v, anotherField, LengthRunes)
 }
 
 // validateGte is the validation function for validating if the current field's value is greater than or equal to the param's value.
-func validateGte(v, anotherField reflect.Value) (bool, error) {
+func validateGte(v, anotherField reflect.Value, mode LengthMode) (bool, error) {
 	if !v.IsValid() || !anotherField.IsValid() {
 		return false, fmt.Errorf("validator: Gte invalid reflection values")
 	}
-	if v.Kind() != anotherField.Kind() {
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
 		return false, fmt.Errorf("validator: Gte The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
 	}
 
@@ -939,15 +909,13 @@ func validateGte(v, anotherField reflect.Value) (bool, error) {
 
 	switch v.Kind() {
 	case reflect.String:
-		valid, err = compareString(v.String(), int64(utf8.RuneCountInString(anotherField.String())), ">=")
+		valid, err = compareStringLength(v.String(), int64(StringLength(anotherField.String(), mode)), ">=", mode)
 	case reflect.Slice, reflect.Map, reflect.Array:
 		valid, err = compareInt64(int64(v.Len()), int64(anotherField.Len()), ">=")
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		valid, err = compareInt64(v.Int(), anotherField.Int(), ">=")
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		valid, err = compareUint64(v.Uint(), anotherField.Uint(), ">=")
-	case reflect.Float32, reflect.Float64:
-		valid, err = compareFloat64(v.Float(), anotherField.Float(), ">=")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		valid, err = compareNumbers(v, anotherField, ">=")
 	default:
 		return false, fmt.Errorf("validator: Gte unsupported type %T", v.Interface())
 	}
@@ -959,7 +927,7 @@ func validateGte(v, anotherField reflect.Value) (bool, error) {
 func ValidateGte(i, a interface{}) (bool, error) {
 	v := reflect.ValueOf(i)
 	anotherField := reflect.ValueOf(a)
-	return validateGte(v, anotherField)
+	return validateGte(v, anotherField, LengthRunes)
 }
 
 // validateDistinct is the validation function for validating an attribute is unique among other values.
@@ -995,38 +963,324 @@ func ValidateDistinct(i interface{}) bool {
 	return valid
 }
 
-// ValidateMimeTypes is the validation function for the file must match one of the given MIME types.
-func ValidateMimeTypes(data []byte, mimeTypes []string) bool {
-	mimeType := http.DetectContentType(data)
-	for _, value := range mimeTypes {
-		if mimeType == value {
+// validateAccepted is the validation function for validating the field is "yes", "on", "1", "true", true or 1.
+func validateAccepted(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return InString(strings.ToLower(v.String()), []string{"yes", "on", "1", "true"}), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 1, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 1, nil
+	}
+
+	return false, fmt.Errorf("validator: Accepted unsupported type %T", v.Interface())
+}
+
+// ValidateAccepted is the validation function for validating the field is "yes", "on", "1", "true", true or 1.
+func ValidateAccepted(i interface{}) bool {
+	v := reflect.ValueOf(i)
+	valid, _ := validateAccepted(v)
+	return valid
+}
+
+// validateBoolean is the validation function for validating the field can be cast as a boolean.
+// Accepted input are true, false, 1, 0, "1", "0", "true" and "false".
+func validateBoolean(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.Bool:
+		return true, nil
+	case reflect.String:
+		return InString(v.String(), []string{"1", "0", "true", "false"}), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0 || v.Int() == 1, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0 || v.Uint() == 1, nil
+	}
+
+	return false, nil
+}
+
+// ValidateBoolean is the validation function for validating the field can be cast as a boolean.
+func ValidateBoolean(i interface{}) bool {
+	v := reflect.ValueOf(i)
+	valid, _ := validateBoolean(v)
+	return valid
+}
+
+// validateFilled is the validation function for validating the field is not empty when it is present.
+// A nil pointer is treated as not present and never reaches this function.
+func validateFilled(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len() > 0, nil
+	}
+
+	return true, nil
+}
+
+// ValidateFilled is the validation function for validating the field is not empty when it is present.
+func ValidateFilled(i interface{}) bool {
+	v := reflect.ValueOf(i)
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && !v.IsNil() {
+		v = v.Elem()
+	}
+	valid, _ := validateFilled(v)
+	return valid
+}
+
+// validateString is the validation function for validating the field is a string.
+func validateString(v reflect.Value) (bool, error) {
+	return v.Kind() == reflect.String, nil
+}
+
+// validatePresent is the validation function for validating the field is present, which means a nil pointer, interface, map or slice fails.
+func validatePresent(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return !v.IsNil()
+	}
+
+	return v.IsValid()
+}
+
+// ValidatePresent is the validation function for validating the field is present, which means a nil pointer, interface, map or slice fails.
+func ValidatePresent(i interface{}) bool {
+	v := reflect.ValueOf(i)
+	return validatePresent(v)
+}
+
+// validateDigits is the validation function for validating the field is numeric and must have an exact length of value.
+func validateDigits(v reflect.Value, params []string) (bool, error) {
+	if len(params) != 1 {
+		return false, fmt.Errorf("validator: Digits params length must be 1")
+	}
+
+	length, err := ToInt(params[0])
+	if err != nil {
+		return false, fmt.Errorf("validator: invalid parameter for Digits rule, value: %w", err)
+	}
+
+	var value string
+	switch v.Kind() {
+	case reflect.String:
+		value = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value = ToString(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value = ToString(v.Uint())
+	default:
+		return false, fmt.Errorf("validator: Digits unsupported type %T", v.Interface())
+	}
+
+	if value == "" || !IsNumeric(value) {
+		return false, nil
+	}
+
+	return int64(len(value)) == length, nil
+}
+
+// ValidateDigits is the validation function for validating the field is numeric and must have an exact length of value.
+func ValidateDigits(i interface{}, params []string) (bool, error) {
+	v := reflect.ValueOf(i)
+	return validateDigits(v, params)
+}
+
+// validateDifferent is the validation function for validating if the current field's value is different from the another field's value.
+func validateDifferent(v, anotherField reflect.Value) (bool, error) {
+	if anotherField.Kind() == reflect.Interface || anotherField.Kind() == reflect.Ptr {
+		if anotherField.IsNil() {
+			return true, nil
+		}
+		anotherField = anotherField.Elem()
+	}
+	if !v.IsValid() || !anotherField.IsValid() {
+		return false, fmt.Errorf("validator: Different invalid reflection values")
+	}
+	if v.Kind() != anotherField.Kind() && !(isNumberKind(v.Kind()) && isNumberKind(anotherField.Kind())) {
+		return false, fmt.Errorf("validator: Different The two fields must be of the same type %T, %T", v.Interface(), anotherField.Interface())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String() != anotherField.String(), nil
+	case reflect.Bool:
+		return v.Bool() != anotherField.Bool(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		same, err := compareNumbers(v, anotherField, "==")
+		return !same, err
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return !reflect.DeepEqual(v.Interface(), anotherField.Interface()), nil
+	}
+
+	return false, fmt.Errorf("validator: Different unsupported type %T", v.Interface())
+}
+
+// ValidateDifferent is the validation function for validating if the current field's value is different from the another field's value.
+func ValidateDifferent(i, a interface{}) (bool, error) {
+	v := reflect.ValueOf(i)
+	anotherField := reflect.ValueOf(a)
+	return validateDifferent(v, anotherField)
+}
+
+// validateConfirmed is the validation function for validating if the current field's value matches its confirmation field.
+func validateConfirmed(v, confirmation reflect.Value) (bool, error) {
+	if !confirmation.IsValid() {
+		return false, fmt.Errorf("validator: Confirmed confirmation field not found")
+	}
+	different, err := validateDifferent(v, confirmation)
+	if err != nil {
+		return false, err
+	}
+	return !different, nil
+}
+
+// collectionValues returns the values of a slice, array or map field as strings.
+func collectionValues(rule string, v reflect.Value) ([]string, error) {
+	if v.Kind() == reflect.Interface || v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return extractValuesFromCollection(v)
+	case reflect.Invalid:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("validator: %s unsupported type %s", rule, v.Type())
+}
+
+// parseDecimal parses a decimal number exactly. Hexadecimal, fractions, infinities and NaN are not numbers here.
+func parseDecimal(str string) (*big.Rat, bool) {
+	if str == "" || strings.ContainsAny(str, "xXpP/_") {
+		return nil, false
+	}
+	return new(big.Rat).SetString(str)
+}
+
+// inValues reports whether str is one of values. Numbers compare by value, so "1", "1.0" and "1e0" are equal.
+func inValues(str string, values []string) bool {
+	number, isNumber := parseDecimal(str)
+	for _, value := range values {
+		if value == str {
 			return true
 		}
+		if isNumber {
+			if other, ok := parseDecimal(value); ok && number.Cmp(other) == 0 {
+				return true
+			}
+		}
 	}
 	return false
 }
 
-// ValidateMimes is the validation function for the file must have a MIME type corresponding to one of the listed extensions.
-func ValidateMimes(data []byte, mimes []string) (bool, error) {
-	mimeTypes := make([]string, len(mimes))
-	for i, mime := range mimes {
-		if val, ok := Mimes[mime]; ok {
-			mimeTypes[i] = Mimes[val]
-		} else {
-			return false, fmt.Errorf("validator: Mimes unsupported type %s", mime)
-		}
+// scalarString returns the string form of a scalar field.
+func scalarString(rule string, v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.String:
+		return ToString(v.Interface()), nil
+	}
+	return "", fmt.Errorf("validator: %s unsupported type %s", rule, v.Type())
+}
+
+// validateIn is the validation function for validating the value is one of the params.
+func validateIn(v reflect.Value, params []string) (bool, error) {
+	str, err := scalarString("In", v)
+	if err != nil {
+		return false, err
+	}
+	return inValues(str, params), nil
+}
+
+// ValidateIn is the validation function for the value must be one of params. Numbers compare by value.
+func ValidateIn(i interface{}, params []string) (bool, error) {
+	return validateIn(reflect.ValueOf(i), params)
+}
+
+// validateNotIn is the validation function for validating the value is none of the params.
+func validateNotIn(v reflect.Value, params []string) (bool, error) {
+	str, err := scalarString("NotIn", v)
+	if err != nil {
+		return false, err
+	}
+	return !inValues(str, params), nil
+}
+
+// ValidateNotIn is the validation function for the value must be none of params. Numbers compare by value.
+func ValidateNotIn(i interface{}, params []string) (bool, error) {
+	return validateNotIn(reflect.ValueOf(i), params)
+}
+
+// validateInArray is the validation function for validating the value is an element of anotherField, a slice, array or map.
+func validateInArray(v, anotherField reflect.Value) (bool, error) {
+	str, err := scalarString("InArray", v)
+	if err != nil {
+		return false, err
+	}
+	values, err := collectionValues("InArray", anotherField)
+	if err != nil {
+		return false, err
+	}
+	return inValues(str, values), nil
+}
+
+// ValidateInArray is the validation function for the value must be an element of a, a slice, array or map.
+// Numbers compare by value.
+func ValidateInArray(i, a interface{}) (bool, error) {
+	return validateInArray(reflect.ValueOf(i), reflect.ValueOf(a))
+}
+
+// validateNotInArray is the validation function for validating the value is not an element of anotherField, a slice, array or map.
+func validateNotInArray(v, anotherField reflect.Value) (bool, error) {
+	str, err := scalarString("NotInArray", v)
+	if err != nil {
+		return false, err
 	}
+	values, err := collectionValues("NotInArray", anotherField)
+	if err != nil {
+		return false, err
+	}
+	return !inValues(str, values), nil
+}
 
-	return ValidateMimeTypes(data, mimeTypes), nil
+// ValidateNotInArray is the validation function for the value must not be an element of a, a slice, array or map.
+// Numbers compare by value.
+func ValidateNotInArray(i, a interface{}) (bool, error) {
+	return validateNotInArray(reflect.ValueOf(i), reflect.ValueOf(a))
 }
 
-// ValidateImage is the validation function for the The file under validation must be an image (jpeg, png, bmp, gif, or svg)
-func ValidateImage(data []byte) bool {
-	v, err := ValidateMimes(data, []string{"jpeg", "png", "gif", "bmp", "svg"})
+// validateSubsetOf is the validation function for validating every element of the collection is an element of anotherField.
+func validateSubsetOf(v, anotherField reflect.Value) (bool, error) {
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array && v.Kind() != reflect.Map {
+		return false, fmt.Errorf("validator: SubsetOf unsupported type %s", v.Type())
+	}
+	elements, err := extractValuesFromCollection(v)
 	if err != nil {
-		return false
+		return false, err
+	}
+	values, err := collectionValues("SubsetOf", anotherField)
+	if err != nil {
+		return false, err
+	}
+	for _, element := range elements {
+		if !inValues(element, values) {
+			return false, nil
+		}
 	}
-	return v
+	return true, nil
+}
+
+// ValidateSubsetOf is the validation function for every element of the slice, array or map i must be an element of a.
+// Numbers compare by value.
+func ValidateSubsetOf(i, a interface{}) (bool, error) {
+	return validateSubsetOf(reflect.ValueOf(i), reflect.ValueOf(a))
 }
 
 // ValidateStruct use tags for fields.
@@ -1037,11 +1291,27 @@ func ValidateStruct(s interface{}) error {
 
 // ValidateStruct use tags for fields.
 // result will be equal to `false` if there are any errors.
+// The modifiers of the mod tags and then the default tags are applied first, in place when s is a pointer.
 func (v *Validator) ValidateStruct(s interface{}, jsonNamespace, structNamespace []byte) error {
 	if s == nil {
 		return nil
 	}
+	s, err := v.prepared(s)
+	if err != nil {
+		return err
+	}
+	err = v.validateStruct(s, jsonNamespace, structNamespace)
+	if err != nil && v.PathFormat != nil {
+		v.formatPaths(err, namespacePath(jsonNamespace))
+	}
+	if err != nil && v.ErrorEncoder != nil {
+		v.setEncoder(err)
+	}
+	return err
+}
 
+// validateStruct validates the fields of the struct s, whose modifiers and defaults have been applied.
+func (v *Validator) validateStruct(s interface{}, jsonNamespace, structNamespace []byte) error {
 	var err error
 
 	val := reflect.ValueOf(s)
@@ -1055,6 +1325,11 @@ func (v *Validator) ValidateStruct(s interface{}, jsonNamespace, structNamespace
 
 	var errs Errors
 	fields := cachedTypefields(val.Type())
+	for i := range fields {
+		if fields[i].refError != nil {
+			return fields[i].refError
+		}
+	}
 
 	// Pre-allocate slice capacity to reduce allocations
 	if len(fields) > 0 {
@@ -1066,6 +1341,7 @@ func (v *Validator) ValidateStruct(s interface{}, jsonNamespace, structNamespace
 		valuefield := val.Field(f.index[0])
 		err := v.newTypeValidator(valuefield, &f, val, jsonNamespace, structNamespace)
 		if err != nil {
+			prependPath(err, PathSegment{Kind: FieldSegment, Name: f.name})
 			if errors, ok := err.(Errors); ok {
 				errs = append(errs, errors...)
 			} else {
@@ -1082,13 +1358,30 @@ func (v *Validator) ValidateStruct(s interface{}, jsonNamespace, structNamespace
 }
 
 func (v *Validator) newTypeValidator(value reflect.Value, f *field, o reflect.Value, jsonNamespace, structNamespace []byte) (resultErr error) {
-	if !value.IsValid() || (f.omitEmpty && Empty(value)) {
+	if !value.IsValid() {
 		return nil
 	}
 
 	name := string(append(jsonNamespace, f.nameBytes...))
 	structName := string(append(structNamespace, f.structName...))
 
+	// Wrapper types such as sql.NullString are validated by the value they hold.
+	value, unwrapped, err := v.unwrapType(value)
+	if err != nil {
+		return v.fieldFuncError(value, f, o, name, structName, err)
+	}
+	if unwrapped && value.Kind() != reflect.Interface {
+		f = f.unwrapped(value.Type())
+	}
+
+	if v.omitted(f, value) {
+		return nil
+	}
+
+	if f.isFile || f.isFileList {
+		return v.validateFileField(value, f, o, name, structName)
+	}
+
 	// Handle pointer and interface dereferencing
 	if value.Kind() == reflect.Interface || value.Kind() == reflect.Ptr {
 		if err := v.checkRequired(value, f, o, name, structName); err != nil {
@@ -1107,6 +1400,11 @@ func (v *Validator) newTypeValidator(value reflect.Value, f *field, o reflect.Va
 		return err
 	}
 
+	// Numbers of math/big are validated as numbers rather than as structs.
+	if isBigNumberType(value.Type()) {
+		return v.validateCommonRules(f.validTags, value, f, name, structName, o)
+	}
+
 	switch value.Kind() {
 	case reflect.Bool,
 		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -1165,30 +1463,41 @@ func (v *Validator) newTypeValidator(value reflect.Value, f *field, o reflect.Va
 	case reflect.Struct:
 		jsonNamespace = append(append(jsonNamespace, f.nameBytes...), '.')
 		structNamespace = append(append(structNamespace, f.structNameBytes...), '.')
-		return v.ValidateStruct(value.Interface(), jsonNamespace, structNamespace)
+		return v.validateStruct(value.Interface(), jsonNamespace, structNamespace)
 	default:
 		// For unsupported types with validation tags, return a FieldError with FuncError
-		if len(f.validTags) > 0 {
-			unsupportedErr := &UnsupportedTypeError{value.Type()}
-			// Return the first validation tag's error with the unsupported type as FuncError
-			return v.formatsMessages(&FieldError{
-				Name:              name,
-				StructName:        structName,
-				Tag:               f.validTags[0].name,
-				MessageName:       f.validTags[0].messageName,
-				MessageParameters: parseValidatorMessageParameters(f.validTags[0], o),
-				Attribute:         f.attribute,
-				DefaultAttribute:  f.defaultAttribute,
-				Value:             ToString(value.Interface()),
-				FuncError:         unsupportedErr,
-			})
-		}
-		return &UnsupportedTypeError{value.Type()}
+		return v.fieldFuncError(value, f, o, name, structName, &UnsupportedTypeError{value.Type()})
 	}
 }
 
-// Empty determine whether a variable is empty
+// fieldFuncError returns err as the FuncError of the error of the first validation tag of the field, or err itself
+// when the field has none.
+func (v *Validator) fieldFuncError(value reflect.Value, f *field, o reflect.Value, name, structName string, err error) error {
+	if len(f.validTags) == 0 {
+		return err
+	}
+	return v.formatsMessages(&FieldError{
+		Name:              name,
+		StructName:        structName,
+		Tag:               f.validTags[0].name,
+		MessageName:       f.validTags[0].messageName,
+		MessageParameters: parseValidatorMessageParameters(f.validTags[0], o),
+		Attribute:         f.attribute,
+		DefaultAttribute:  f.defaultAttribute,
+		Value:             ToString(value.Interface()),
+		FuncError:         err,
+	})
+}
+
+// Empty determine whether a variable is empty. Types with an IsZero method, such as time.Time, are empty when it
+// returns true.
 func Empty(v reflect.Value) bool {
+	if v.Kind() != reflect.Interface && v.Kind() != reflect.Ptr {
+		if zero, ok := callIsZero(v); ok {
+			return zero
+		}
+	}
+
 	switch v.Kind() {
 	case reflect.String, reflect.Array:
 		return v.Len() == 0
@@ -1231,7 +1540,7 @@ func ValidateRequired(i interface{}) bool {
 }
 
 // validateRequiredIf check value required when anotherField str is a member of the set of strings params
-func validateRequiredIf(v, anotherField reflect.Value, params []string, tag *ValidTag) (bool, error) {
+func validateRequiredIf(empty EmptyFunc, v, anotherField reflect.Value, params []string, tag *ValidTag) (bool, error) {
 	if anotherField.Kind() == reflect.Interface || anotherField.Kind() == reflect.Ptr {
 		anotherField = anotherField.Elem()
 	}
@@ -1247,7 +1556,7 @@ func validateRequiredIf(v, anotherField reflect.Value, params []string, tag *Val
 		reflect.Float32, reflect.Float64,
 		reflect.String:
 		value := ToString(anotherField)
-		if InString(value, params) && Empty(v) && tag != nil {
+		if InString(value, params) && empty(v) && tag != nil {
 			tag.messageParameters = append(
 				tag.messageParameters,
 				messageParameter{
@@ -1262,7 +1571,7 @@ func validateRequiredIf(v, anotherField reflect.Value, params []string, tag *Val
 		if err != nil {
 			return false, err
 		}
-		return checkRequiredIfCondition(v, values, params, tag)
+		return checkRequiredIfCondition(empty, v, values, params, tag)
 	default:
 		return false, fmt.Errorf("validator: RequiredIf unsupported type %T", anotherField.Interface())
 	}
@@ -1271,7 +1580,7 @@ func validateRequiredIf(v, anotherField reflect.Value, params []string, tag *Val
 }
 
 // validateRequiredUnless check value required when anotherField str is a member of the set of strings params
-func validateRequiredUnless(v, anotherField reflect.Value, params []string) (bool, error) {
+func validateRequiredUnless(empty EmptyFunc, v, anotherField reflect.Value, params []string) (bool, error) {
 	if anotherField.Kind() == reflect.Interface || anotherField.Kind() == reflect.Ptr {
 		anotherField = anotherField.Elem()
 	}
@@ -1288,7 +1597,7 @@ func validateRequiredUnless(v, anotherField reflect.Value, params []string) (boo
 		reflect.String:
 		value := ToString(anotherField)
 		if !InString(value, params) {
-			if Empty(v) {
+			if empty(v) {
 				return false, nil
 			}
 		}
@@ -1312,7 +1621,7 @@ func validateRequiredUnless(v, anotherField reflect.Value, params []string) (boo
 
 		for _, value := range values {
 			if !InString(value, params) {
-				if Empty(v) {
+				if empty(v) {
 					return false, nil
 				}
 			}
@@ -1334,7 +1643,7 @@ func validateRequiredUnless(v, anotherField reflect.Value, params []string) (boo
 
 		for _, value := range values {
 			if !InString(value, params) {
-				if Empty(v) {
+				if empty(v) {
 					return false, nil
 				}
 			}
@@ -1347,13 +1656,13 @@ func validateRequiredUnless(v, anotherField reflect.Value, params []string) (boo
 }
 
 // allFailingRequired validate that an attribute exists when all other attributes do not.
-func allFailingRequired(parameters []string, v reflect.Value) bool {
+func allFailingRequired(empty EmptyFunc, parameters []string, v reflect.Value) bool {
 	for _, p := range parameters {
 		anotherField, err := findField(p, v)
 		if err != nil {
 			continue
 		}
-		if !Empty(anotherField) {
+		if !empty(anotherField) {
 			return false
 		}
 	}
@@ -1361,13 +1670,13 @@ func allFailingRequired(parameters []string, v reflect.Value) bool {
 }
 
 // anyFailingRequired determine if any of the given attributes fail the required test.
-func anyFailingRequired(parameters []string, v reflect.Value) bool {
+func anyFailingRequired(empty EmptyFunc, parameters []string, v reflect.Value) bool {
 	for _, p := range parameters {
 		anotherField, err := findField(p, v)
 		if err != nil {
 			return true
 		}
-		if Empty(anotherField) {
+		if empty(anotherField) {
 			return true
 		}
 	}
@@ -1381,14 +1690,14 @@ func (v *Validator) checkRequired(value reflect.Value, f *field, o reflect.Value
 		var isValid bool
 		switch tag.name {
 		case "required":
-			isError = !validateRequired(value)
+			isError = v.empty(value)
 		case "requiredIf":
 			if len(tag.params) == 0 {
 				continue
 			}
 			anotherField, err := findField(tag.params[0], o)
 			if err == nil && len(tag.params) >= 2 {
-				isValid, funcError = validateRequiredIf(value, anotherField, tag.params[1:], tag)
+				isValid, funcError = validateRequiredIf(v.empty, value, anotherField, tag.params[1:], tag)
 				if !isValid {
 					isError = true
 				}
@@ -1399,27 +1708,29 @@ func (v *Validator) checkRequired(value reflect.Value, f *field, o reflect.Value
 			}
 			anotherField, err := findField(tag.params[0], o)
 			if err == nil && len(tag.params) >= 2 {
-				isValid, funcError = validateRequiredUnless(value, anotherField, tag.params[1:])
+				isValid, funcError = validateRequiredUnless(v.empty, value, anotherField, tag.params[1:])
 				if !isValid {
 					isError = true
 				}
 			}
 		case "requiredWith":
-			if !validateRequiredWith(tag.params, value, o) {
+			if !validateRequiredWith(v.empty, tag.params, value, o) {
 				isError = true
 			}
 		case "requiredWithAll":
-			if !validateRequiredWithAll(tag.params, value, o) {
+			if !validateRequiredWithAll(v.empty, tag.params, value, o) {
 				isError = true
 			}
 		case "requiredWithout":
-			if !validateRequiredWithout(tag.params, value, o) {
+			if !validateRequiredWithout(v.empty, tag.params, value, o) {
 				isError = true
 			}
 		case "requiredWithoutAll":
-			if !validateRequiredWithoutAll(tag.params, value, o) {
+			if !validateRequiredWithoutAll(v.empty, tag.params, value, o) {
 				isError = true
 			}
+		case "present":
+			isError = !validatePresent(value)
 		}
 
 		if isError {
@@ -1441,33 +1752,33 @@ func (v *Validator) checkRequired(value reflect.Value, f *field, o reflect.Value
 }
 
 // validateRequiredWith The field under validation must be present and not empty only if any of the other specified fields are present.
-func validateRequiredWith(otherFields []string, currentField, obj reflect.Value) bool {
-	if !allFailingRequired(otherFields, obj) {
-		return validateRequired(currentField)
+func validateRequiredWith(empty EmptyFunc, otherFields []string, currentField, obj reflect.Value) bool {
+	if !allFailingRequired(empty, otherFields, obj) {
+		return !empty(currentField)
 	}
 	return true
 }
 
 // validateRequiredWithAll The field under validation must be present and not empty only if all of the other specified fields are present.
-func validateRequiredWithAll(otherFields []string, currentField, obj reflect.Value) bool {
-	if !anyFailingRequired(otherFields, obj) {
-		return validateRequired(currentField)
+func validateRequiredWithAll(empty EmptyFunc, otherFields []string, currentField, obj reflect.Value) bool {
+	if !anyFailingRequired(empty, otherFields, obj) {
+		return !empty(currentField)
 	}
 	return true
 }
 
 // RequiredWithout The field under validation must be present and not empty only when any of the other specified fields are not present.
-func validateRequiredWithout(otherFields []string, currentField, obj reflect.Value) bool {
-	if anyFailingRequired(otherFields, obj) {
-		return validateRequired(currentField)
+func validateRequiredWithout(empty EmptyFunc, otherFields []string, currentField, obj reflect.Value) bool {
+	if anyFailingRequired(empty, otherFields, obj) {
+		return !empty(currentField)
 	}
 	return true
 }
 
 // validateRequiredWithoutAll The field under validation must be present and not empty only when all of the other specified fields are not present.
-func validateRequiredWithoutAll(otherFields []string, currentField, obj reflect.Value) bool {
-	if allFailingRequired(otherFields, obj) {
-		return validateRequired(currentField)
+func validateRequiredWithoutAll(empty EmptyFunc, otherFields []string, currentField, obj reflect.Value) bool {
+	if allFailingRequired(empty, otherFields, obj) {
+		return !empty(currentField)
 	}
 	return true
 }
@@ -1496,7 +1807,10 @@ func parseValidatorMessageParameters(validTag *ValidTag, o reflect.Value) Messag
 				Value: buff.String(),
 			},
 		)
-	case "requiredIf", "requiredUnless", "same":
+	case "requiredIf", "requiredUnless", "same", "different", "inArray", "notInArray", "subsetOf":
+		if len(validTag.params) == 0 {
+			break
+		}
 		other := getDisplayableAttribute(o, validTag.params[0])
 		messageParameters = append(
 			messageParameters,
@@ -1585,62 +1899,102 @@ func (v *Validator) checkDependentRulesWithStatus(validTag *ValidTag, f *field,
 	var anotherField reflect.Value
 	var err error
 	var handled bool
+	var mode LengthMode
+
+	if validTag.name == "password" {
+		return true, v.checkPassword(validTag, f, value, o, name, structName)
+	}
 
 	switch validTag.name {
 	case "gt", "gte", "lt", "lte":
+		validTag = v.lengthTag(validTag, value)
 		// Check if the parameter is numeric (parameter comparison) or a field name (field comparison)
+		fieldName := ""
 		if len(validTag.params) > 0 {
-			if _, err := ToFloat(validTag.params[0]); err == nil {
+			fieldName, mode, _, _ = splitLengthMode(validTag.params[0])
+			if _, err := ToFloat(fieldName); err == nil {
 				// It's a numeric parameter, skip field lookup and let ParamRuleMap handle it
 				return false, nil
 			}
 		}
 		// It's a field name, proceed with field comparison
-		anotherField, err = findField(validTag.params[0], o)
+		anotherField, err = findField(fieldName, o)
 		if err != nil {
 			return false, nil
 		}
 		handled = true
-	case "same":
+	case "same", "different", "inArray", "notInArray", "subsetOf":
+		if len(validTag.params) != 1 {
+			return false, fmt.Errorf("validator: %s params length must be 1", strings.ToUpper(validTag.name[:1])+validTag.name[1:])
+		}
 		anotherField, err = findField(validTag.params[0], o)
 		if err != nil {
 			return false, nil
 		}
 		handled = true
+	case "confirmed":
+		anotherField, err = findField(f.attribute+"Confirmation", o)
+		if err != nil {
+			return false, nil
+		}
+		handled = true
+	case "postcodeField":
+		// A missing country field is reported by validatePostcodeField.
+		if len(validTag.params) > 0 {
+			anotherField, _ = findField(validTag.params[0], o)
+		}
+		handled = true
+	}
+
+	errorValue := value
+	if handled && (f.isFile || f.isFileList) {
+		value, anotherField = fileComparands(validTag.name, value, anotherField)
 	}
 
 	switch validTag.name {
 	case "gt":
 		// Only handle field comparison, parameter comparison is handled by ParamRuleMap
 		if anotherField.IsValid() {
-			isValid, funcError = validateGt(value, anotherField)
+			isValid, funcError = validateGt(value, anotherField, mode)
 		} else {
 			return false, nil // Let ParamRuleMap handle it
 		}
 	case "gte":
 		if anotherField.IsValid() {
-			isValid, funcError = validateGte(value, anotherField)
+			isValid, funcError = validateGte(value, anotherField, mode)
 		} else {
 			return false, nil
 		}
 	case "lt":
 		if anotherField.IsValid() {
-			isValid, funcError = validateLt(value, anotherField)
+			isValid, funcError = validateLt(value, anotherField, mode)
 		} else {
 			return false, nil
 		}
 	case "lte":
 		if anotherField.IsValid() {
-			isValid, funcError = validateLte(value, anotherField)
+			isValid, funcError = validateLte(value, anotherField, mode)
 		} else {
 			return false, nil
 		}
 	case "same":
 		isValid, funcError = validateSame(value, anotherField)
+	case "different":
+		isValid, funcError = validateDifferent(value, anotherField)
+	case "confirmed":
+		isValid, funcError = validateConfirmed(value, anotherField)
+	case "inArray":
+		isValid, funcError = validateInArray(value, anotherField)
+	case "notInArray":
+		isValid, funcError = validateNotInArray(value, anotherField)
+	case "subsetOf":
+		isValid, funcError = validateSubsetOf(value, anotherField)
+	case "postcodeField":
+		isValid, funcError = validatePostcodeField(value, anotherField)
 	}
 
 	if !isValid {
-		return handled, v.formatsMessages(&FieldError{
+		fieldError := &FieldError{
 			Name:              name,
 			StructName:        structName,
 			Tag:               validTag.name,
@@ -1648,9 +2002,13 @@ func (v *Validator) checkDependentRulesWithStatus(validTag *ValidTag, f *field,
 			MessageParameters: parseValidatorMessageParameters(validTag, o),
 			Attribute:         f.attribute,
 			DefaultAttribute:  f.defaultAttribute,
-			Value:             ToString(value.Interface()),
+			Value:             fieldErrorValue(errorValue, f),
 			FuncError:         funcError,
-		})
+		}
+		if funcError == nil && lengthRules[validTag.name] && anotherField.IsValid() {
+			fieldError.detail = ruleDetail(comparisonTag(validTag, anotherField, mode), value, ToString(value.Interface()))
+		}
+		return handled, v.formatsMessages(fieldError)
 	}
 
 	return handled, nil
@@ -1660,3 +2018,45 @@ func (v *Validator) checkDependentRules(validTag *ValidTag, f *field, value, o r
 	_, err := v.checkDependentRulesWithStatus(validTag, f, value, o, name, structName)
 	return err
 }
+
+// validateCreditCard is the validation function for validating the string is a credit card number of one of the given brands, or any brand without params.
+func validateCreditCard(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: CreditCard unsupported type %s", v.Type())
+	}
+	return ValidateCreditCard(v.String(), params...), nil
+}
+
+// validateUUIDOptions is the validation function for validating the string matches the uuid rule,
+// with the options "uppercase" and "braced" of params.
+func validateUUIDOptions(rule string, validate func(string, ...string) bool, v reflect.Value, params []string) (bool, error) {
+	for _, param := range params {
+		if param != "uppercase" && param != "braced" {
+			return false, fmt.Errorf("validator: %s unknown option %s", rule, param)
+		}
+	}
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: %s unsupported type %s", rule, v.Type())
+	}
+	return validate(v.String(), params...), nil
+}
+
+// validateUUID1 is the validation function for validating the string is an uuid1.
+func validateUUID1(v reflect.Value, params []string) (bool, error) {
+	return validateUUIDOptions("UUID1", ValidateUUID1, v, params)
+}
+
+// validateUUID6 is the validation function for validating the string is an uuid6.
+func validateUUID6(v reflect.Value, params []string) (bool, error) {
+	return validateUUIDOptions("UUID6", ValidateUUID6, v, params)
+}
+
+// validateUUID7 is the validation function for validating the string is an uuid7.
+func validateUUID7(v reflect.Value, params []string) (bool, error) {
+	return validateUUIDOptions("UUID7", ValidateUUID7, v, params)
+}
+
+// validateUUIDAny is the validation function for validating the string is an uuid of any version.
+func validateUUIDAny(v reflect.Value, params []string) (bool, error) {
+	return validateUUIDOptions("UUIDAny", ValidateUUIDAny, v, params)
+}
@@ -0,0 +1,212 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+var (
+	bigIntType   = reflect.TypeOf((*big.Int)(nil)).Elem()
+	bigFloatType = reflect.TypeOf((*big.Float)(nil)).Elem()
+	bigRatType   = reflect.TypeOf((*big.Rat)(nil)).Elem()
+)
+
+// isBigNumberType reports whether the type is big.Int, big.Float or big.Rat.
+func isBigNumberType(t reflect.Type) bool {
+	return t == bigIntType || t == bigFloatType || t == bigRatType
+}
+
+// bigNumber returns the *big.Int, *big.Float or *big.Rat held by the value, by pointer or by value.
+func bigNumber(v reflect.Value) (interface{}, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() || !isBigNumberType(v.Type().Elem()) {
+			return nil, false
+		}
+		return v.Interface(), true
+	}
+	if !isBigNumberType(v.Type()) {
+		return nil, false
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p.Interface(), true
+}
+
+// compareBigParam determine if a comparison passes between a math/big number and a decimal param. big.Float values
+// are compared with the param rounded to their own precision, as float32 and float64 values are.
+func compareBigParam(n interface{}, param, operator string) (bool, error) {
+	if f, ok := n.(*big.Float); ok {
+		p, _, err := big.ParseFloat(param, 10, f.Prec(), f.Mode())
+		if err != nil {
+			return false, err
+		}
+		return compareSign(f.Cmp(p), operator)
+	}
+
+	p, ok := parseDecimal(param)
+	if !ok {
+		return false, fmt.Errorf("validator: invalid number %s", param)
+	}
+	switch n := n.(type) {
+	case *big.Int:
+		return compareSign(new(big.Rat).SetInt(n).Cmp(p), operator)
+	default:
+		return compareSign(n.(*big.Rat).Cmp(p), operator)
+	}
+}
+
+// decimalRat returns the exact decimal value of a number of any kind, a math/big number or a decimal string. Floats are
+// read as the fewest decimal digits that give the same float, so a float64 0.1 is 0.1 rather than its binary value.
+// The result is nil for strings that are not decimal numbers, NaN and infinities.
+func decimalRat(rule string, v reflect.Value) (*big.Rat, error) {
+	if n, ok := bigNumber(v); ok {
+		switch n := n.(type) {
+		case *big.Int:
+			return new(big.Rat).SetInt(n), nil
+		case *big.Float:
+			if n.IsInf() {
+				return nil, nil
+			}
+			r, _ := parseDecimal(n.Text('f', -1))
+			return r, nil
+		default:
+			return n.(*big.Rat), nil
+		}
+	}
+
+	switch {
+	case v.Kind() == reflect.String:
+		r, _ := parseDecimal(v.String())
+		return r, nil
+	case isIntKind(v.Kind()):
+		return new(big.Rat).SetInt64(v.Int()), nil
+	case isUintKind(v.Kind()):
+		return numberRat(v), nil
+	case isFloatKind(v.Kind()):
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, nil
+		}
+		r, _ := parseDecimal(strconv.FormatFloat(f, 'f', -1, v.Type().Bits()))
+		return r, nil
+	}
+	return nil, fmt.Errorf("validator: %s unsupported type %s", rule, v.Type())
+}
+
+// decimalFits reports whether the number has at most precision digits, of which at most scale follow the decimal point,
+// as a SQL DECIMAL(precision, scale) column holds.
+func decimalFits(r *big.Rat, precision, scale int) bool {
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(pow))
+	if !scaled.IsInt() {
+		return false
+	}
+	limit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)
+	return new(big.Int).Abs(scaled.Num()).Cmp(limit) < 0
+}
+
+// ValidateDecimal check if the string is a decimal number with at most precision digits, of which at most scale follow
+// the decimal point, such as 12345.67 for precision 7 and scale 2. Empty string is valid.
+func ValidateDecimal(str string, precision, scale int) bool {
+	if IsNull(str) {
+		return true
+	}
+	r, ok := parseDecimal(str)
+	return ok && decimalFits(r, precision, scale)
+}
+
+// validateDecimal is the validation function for validating the number fits the precision and scale of params,
+// decimal=precision|scale. The scale defaults to 0. Strings, numbers and math/big numbers are supported.
+func validateDecimal(v reflect.Value, params []string) (bool, error) {
+	if len(params) != 1 && len(params) != 2 {
+		return false, fmt.Errorf("validator: Decimal params length must be 1 or 2")
+	}
+	precision, err := strconv.Atoi(params[0])
+	if err != nil || precision < 1 {
+		return false, fmt.Errorf("validator: Decimal invalid precision %s", params[0])
+	}
+	scale := 0
+	if len(params) == 2 {
+		if scale, err = strconv.Atoi(params[1]); err != nil || scale < 0 || scale > precision {
+			return false, fmt.Errorf("validator: Decimal invalid scale %s", params[1])
+		}
+	}
+
+	if v.Kind() == reflect.String && IsNull(v.String()) {
+		return true, nil
+	}
+	r, err := decimalRat("Decimal", v)
+	if err != nil {
+		return false, err
+	}
+	return r != nil && decimalFits(r, precision, scale), nil
+}
+
+// ValidateMultipleOf check if the string is a decimal number that is an exact multiple of the decimal multiple, such as
+// 1.15 of 0.05. Empty string is valid.
+func ValidateMultipleOf(str string, multiple string) (bool, error) {
+	m, ok := parseDecimal(multiple)
+	if !ok || m.Sign() == 0 {
+		return false, fmt.Errorf("validator: MultipleOf invalid multiple %s", multiple)
+	}
+	if IsNull(str) {
+		return true, nil
+	}
+	r, ok := parseDecimal(str)
+	return ok && new(big.Rat).Quo(r, m).IsInt(), nil
+}
+
+// validateMultipleOf is the validation function for validating the number is an exact multiple of the param.
+// Strings, numbers and math/big numbers are supported.
+func validateMultipleOf(v reflect.Value, params []string) (bool, error) {
+	if len(params) != 1 {
+		return false, fmt.Errorf("validator: MultipleOf params length must be 1")
+	}
+	m, ok := parseDecimal(params[0])
+	if !ok || m.Sign() == 0 {
+		return false, fmt.Errorf("validator: MultipleOf invalid multiple %s", params[0])
+	}
+
+	if v.Kind() == reflect.String && IsNull(v.String()) {
+		return true, nil
+	}
+	r, err := decimalRat("MultipleOf", v)
+	if err != nil {
+		return false, err
+	}
+	return r != nil && new(big.Rat).Quo(r, m).IsInt(), nil
+}
+
+// validateSign reports whether the sign of the number satisfies fn. Strings that are not decimal numbers, NaN and
+// infinities are invalid, while the empty string is valid.
+func validateSign(rule string, v reflect.Value, fn func(sign int) bool) (bool, error) {
+	if v.Kind() == reflect.String && IsNull(v.String()) {
+		return true, nil
+	}
+	r, err := decimalRat(rule, v)
+	if err != nil {
+		return false, err
+	}
+	return r != nil && fn(r.Sign()), nil
+}
+
+// validatePositive is the validation function for validating the number is greater than zero.
+func validatePositive(v reflect.Value) (bool, error) {
+	return validateSign("Positive", v, func(sign int) bool { return sign > 0 })
+}
+
+// validateNegative is the validation function for validating the number is less than zero.
+func validateNegative(v reflect.Value) (bool, error) {
+	return validateSign("Negative", v, func(sign int) bool { return sign < 0 })
+}
+
+// validateNonZero is the validation function for validating the number is not zero.
+func validateNonZero(v reflect.Value) (bool, error) {
+	return validateSign("NonZero", v, func(sign int) bool { return sign != 0 })
+}
@@ -0,0 +1,174 @@
+package validator
+
+import (
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestValidateDecimal(t *testing.T) {
+	var tests = []struct {
+		str       string
+		precision int
+		scale     int
+		expected  bool
+	}{
+		{"", 5, 2, true},
+		{"123.45", 5, 2, true},
+		{"-123.45", 5, 2, true},
+		{"123.450", 5, 2, true},
+		{"1234.5", 5, 2, false},
+		{"12.345", 5, 2, false},
+		{"1e2", 5, 2, true},
+		{"999999999999.99", 14, 2, true},
+		{"1000000000000.00", 14, 2, false},
+		{"12", 2, 0, true},
+		{"abc", 5, 2, false},
+	}
+	for _, test := range tests {
+		if actual := ValidateDecimal(test.str, test.precision, test.scale); actual != test.expected {
+			t.Errorf("Expected ValidateDecimal(%q, %d, %d) to be %t, got %t", test.str, test.precision, test.scale, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateMultipleOf(t *testing.T) {
+	var tests = []struct {
+		str      string
+		multiple string
+		expected bool
+	}{
+		{"", "0.05", true},
+		{"1.15", "0.05", true},
+		{"1.17", "0.05", false},
+		{"-30", "15", true},
+		{"0", "7", true},
+	}
+	for _, test := range tests {
+		actual, err := ValidateMultipleOf(test.str, test.multiple)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ValidateMultipleOf(%q, %q) to be %t, got %t %v", test.str, test.multiple, test.expected, actual, err)
+		}
+	}
+	if _, err := ValidateMultipleOf("1", "0"); err == nil {
+		t.Error("Expected an error for a zero multiple")
+	}
+}
+
+func TestDecimalRules(t *testing.T) {
+	var tests = []struct {
+		value    interface{}
+		rule     string
+		params   []string
+		expected bool
+	}{
+		{0.1, "decimal", []string{"3", "2"}, true},
+		{float32(0.15), "decimal", []string{"3", "2"}, true},
+		{0.125, "decimal", []string{"3", "2"}, false},
+		{12345, "decimal", []string{"5"}, true},
+		{big.NewInt(123456), "decimal", []string{"5"}, false},
+		{big.NewRat(1, 3), "decimal", []string{"10", "5"}, false},
+		{big.NewRat(1, 4), "decimal", []string{"3", "2"}, true},
+		{math.NaN(), "decimal", []string{"3", "2"}, false},
+		{1.15, "multipleOf", []string{"0.05"}, true},
+		{uint(10), "multipleOf", []string{"3"}, false},
+		{"0.30", "multipleOf", []string{"0.1"}, true},
+		{new(big.Float).SetFloat64(2.5), "multipleOf", []string{"0.5"}, true},
+		{1, "positive", nil, true},
+		{0, "positive", nil, false},
+		{"-0.01", "negative", nil, true},
+		{"abc", "negative", nil, false},
+		{uint8(0), "nonZero", nil, false},
+		{big.NewInt(-1), "nonZero", nil, true},
+		{"", "positive", nil, true},
+	}
+	for _, test := range tests {
+		var actual bool
+		var err error
+		if validfunc, ok := ParamRuleMap[test.rule]; ok {
+			actual, err = validfunc(reflect.ValueOf(test.value), test.params)
+		} else {
+			actual, err = RuleMap[test.rule](reflect.ValueOf(test.value))
+		}
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected %s %v of %T(%v) to be %t, got %t %v", test.rule, test.params, test.value, test.value, test.expected, actual, err)
+		}
+	}
+
+	if _, err := validateDecimal(reflect.ValueOf(1), []string{"2", "3"}); err == nil {
+		t.Error("Expected an error for a scale greater than the precision")
+	}
+	if _, err := validatePositive(reflect.ValueOf([]int{1})); err == nil {
+		t.Error("Expected an error for an unsupported type")
+	}
+}
+
+func TestBigNumberSizeRules(t *testing.T) {
+	var tests = []struct {
+		value    interface{}
+		rule     func(interface{}, []string) (bool, error)
+		params   []string
+		expected bool
+	}{
+		{big.NewInt(5), ValidateMax, []string{"5"}, true},
+		{new(big.Int).Lsh(big.NewInt(1), 100), ValidateMax, []string{"1e30"}, false},
+		{*big.NewInt(5), ValidateMin, []string{"5.5"}, false},
+		{big.NewRat(1, 100), ValidateBetween, []string{"0.01", "999999999999.99"}, true},
+		{big.NewRat(99999999999999, 100), ValidateBetween, []string{"0.01", "999999999999.99"}, true},
+		{big.NewRat(100000000000000, 100), ValidateBetween, []string{"0.01", "999999999999.99"}, false},
+		{new(big.Float).SetFloat64(0.1), ValidateSize, []string{"0.1"}, true},
+		{new(big.Float).SetInf(false), ValidateGtParam, []string{"1e300"}, true},
+		{big.NewRat(-1, 2), ValidateLtParam, []string{"0"}, true},
+	}
+	for _, test := range tests {
+		actual, err := test.rule(test.value, test.params)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected %T(%v) with %v to be %t, got %t %v", test.value, test.value, test.params, test.expected, actual, err)
+		}
+	}
+}
+
+func TestValidateNumericStrings(t *testing.T) {
+	type Payment struct {
+		Amount   string     `valid:"required,numeric,decimal=10|2,between=1|100"`
+		Price    string     `valid:"decimal=14|2,min=0.01,max=999999999999.99"`
+		Code     string     `valid:"max=3"`
+		Total    *big.Float `valid:"max=100"`
+		Quantity big.Int    `valid:"positive,max=10"`
+		Phone    string     `valid:"numeric,max=11"`
+		Ratio    string     `valid:"max=10,decimal=20|2"`
+		Factor   string     `valid:"float,max=10"`
+	}
+
+	var tests = []struct {
+		param    Payment
+		expected string
+	}{
+		{Payment{Amount: "50", Price: "0.01", Code: "ABC", Total: big.NewFloat(99.5), Quantity: *big.NewInt(10)}, ""},
+		{Payment{Amount: "500", Price: "1", Quantity: *big.NewInt(1)}, "The Amount must be between 1 and 100."},
+		{Payment{Amount: "5", Price: "1000000000000", Quantity: *big.NewInt(1)}, "The Price must have at most 14 digits and 2 decimal places."},
+		{Payment{Amount: "5", Price: "0.001", Quantity: *big.NewInt(1)}, "The Price must have at most 14 digits and 2 decimal places."},
+		{Payment{Amount: "5", Price: "0", Quantity: *big.NewInt(1)}, "The Price must be at least 0.01."},
+		{Payment{Amount: "5", Price: "1", Code: "ABCD", Quantity: *big.NewInt(1)}, "The Code may not be greater than 3 characters."},
+		{Payment{Amount: "5", Price: "1", Total: big.NewFloat(100.5), Quantity: *big.NewInt(1)}, "The Total may not be greater than 100."},
+		{Payment{Amount: "5", Price: "1"}, "The Quantity must be a positive number."},
+		{Payment{Amount: "abc", Price: "1", Quantity: *big.NewInt(1)}, "The Amount must be a number."},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Phone: "13800138000"}, ""},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Phone: "138001380001"}, "The Phone may not be greater than 11 characters."},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Ratio: "9e99999999"}, "The Ratio may not be greater than 10."},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Ratio: "1E5000000"}, "The Ratio may not be greater than 10."},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Factor: "9e99999999"}, ""},
+		{Payment{Amount: "5", Price: "1", Quantity: *big.NewInt(1), Factor: "1E500000000"}, "The Factor may not be greater than 10 characters."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		actual := ""
+		if err != nil {
+			actual = err.(Errors)[0].Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%+v) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
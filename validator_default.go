@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const defaultTagName string = "default"
+
+var (
+	durationType        = reflect.TypeOf(time.Duration(0))
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// SetDefaults sets the zero fields of the struct s, which must be a pointer, and of its nested structs to the values
+// of their default tags.
+func (v *Validator) SetDefaults(s interface{}) error {
+	val := reflect.ValueOf(s)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validator: SetDefaults only accepts pointers to structs; got %T", s)
+	}
+	return v.prepareStruct(val.Elem(), false, true)
+}
+
+// setDefault sets a zero field of the struct o to its default, and reports a tag error when the default fails the
+// rules of the field.
+func (v *Validator) setDefault(o reflect.Value, f *modField, field reflect.Value) error {
+	sf := o.Type().Field(f.index)
+	if err := parseDefault(field, f.defaultValue); err != nil {
+		return fmt.Errorf("validator: invalid default %q of %s.%s: %w", f.defaultValue, o.Type().Name(), sf.Name, err)
+	}
+
+	//nolint:gocritic // Field struct copying is acceptable for validation library performance
+	for _, tf := range cachedTypefields(o.Type()) {
+		if len(tf.index) != 1 || tf.index[0] != f.index {
+			continue
+		}
+		if err := v.newTypeValidator(field, &tf, o, nil, nil); err != nil {
+			return fmt.Errorf("validator: default %q of %s.%s fails its rules: %w", f.defaultValue, o.Type().Name(), sf.Name, err)
+		}
+	}
+	return nil
+}
+
+// parseDefault parses the default into the settable value. Pointers are allocated, slices are written as a|b, and
+// types implementing encoding.TextUnmarshaler, such as time.Time, parse their own text.
+func parseDefault(value reflect.Value, def string) error {
+	if value.Kind() == reflect.Ptr {
+		elem := reflect.New(value.Type().Elem())
+		if err := parseDefault(elem.Elem(), def); err != nil {
+			return err
+		}
+		value.Set(elem)
+		return nil
+	}
+	if reflect.PtrTo(value.Type()).Implements(textUnmarshalerType) {
+		return value.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(def))
+	}
+	if value.Type() == durationType {
+		d, err := time.ParseDuration(def)
+		if err != nil {
+			return err
+		}
+		value.SetInt(int64(d))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(def)
+	case reflect.Bool:
+		if def != "true" && def != "1" && def != "false" && def != "0" {
+			return fmt.Errorf("%q is not a boolean", def)
+		}
+		value.SetBool(ToBool(def))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := ToInt(def)
+		if err != nil {
+			return err
+		}
+		if value.OverflowInt(i) {
+			return fmt.Errorf("%s overflows %s", def, value.Type())
+		}
+		value.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := ToUint(def)
+		if err != nil {
+			return err
+		}
+		if value.OverflowUint(u) {
+			return fmt.Errorf("%s overflows %s", def, value.Type())
+		}
+		value.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		fl, err := ToFloat(def)
+		if err != nil {
+			return err
+		}
+		if value.OverflowFloat(fl) {
+			return fmt.Errorf("%s overflows %s", def, value.Type())
+		}
+		value.SetFloat(fl)
+	case reflect.Slice:
+		items := strings.Split(def, "|")
+		slice := reflect.MakeSlice(value.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := parseDefault(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		value.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", value.Type())
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type defaultPage struct {
+	Size int `default:"20" valid:"between=1|100"`
+}
+
+type defaultQuery struct {
+	Sort     string        `valid:"omitempty,in=asc|desc" default:"asc"`
+	Limit    int           `default:"20" valid:"max=100"`
+	Ratio    float32       `default:"0.5"`
+	Active   bool          `default:"true"`
+	Retries  *uint8        `default:"3"`
+	Depth    **int         `default:"2"`
+	Timeout  time.Duration `default:"1m30s"`
+	Since    time.Time     `default:"2024-01-02T15:04:05Z"`
+	Fields   []string      `default:"id|name"`
+	Weights  []int         `default:"1|2|3"`
+	Page     defaultPage   `valid:"required"`
+	Pages    []*defaultPage
+	Internal string
+}
+
+func TestSetDefaults(t *testing.T) {
+	q := &defaultQuery{Limit: 50, Pages: []*defaultPage{{}}}
+	if err := New().SetDefaults(q); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	since, _ := time.Parse(time.RFC3339, "2024-01-02T15:04:05Z")
+	switch {
+	case q.Sort != "asc", q.Limit != 50, q.Ratio != 0.5, !q.Active:
+		t.Errorf("Unexpected scalar defaults %+v", q)
+	case q.Retries == nil || *q.Retries != 3, q.Depth == nil || **q.Depth != 2:
+		t.Errorf("Unexpected pointer defaults %v %v", q.Retries, q.Depth)
+	case q.Timeout != 90*time.Second, !q.Since.Equal(since):
+		t.Errorf("Unexpected time defaults %v %v", q.Timeout, q.Since)
+	case !reflect.DeepEqual(q.Fields, []string{"id", "name"}), !reflect.DeepEqual(q.Weights, []int{1, 2, 3}):
+		t.Errorf("Unexpected slice defaults %v %v", q.Fields, q.Weights)
+	case q.Page.Size != 20, q.Pages[0].Size != 20:
+		t.Errorf("Expected nested structs to be filled, got %+v %+v", q.Page, q.Pages[0])
+	}
+
+	if err := New().SetDefaults(defaultQuery{}); err == nil {
+		t.Error("Expected an error for a struct passed by value")
+	}
+}
+
+func TestValidateStructDefaults(t *testing.T) {
+	q := &defaultQuery{}
+	if err := ValidateStruct(q); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if q.Sort != "asc" || q.Limit != 20 {
+		t.Errorf("Expected the defaults to be set, got %+v", q)
+	}
+
+	q = &defaultQuery{Sort: "random"}
+	err := ValidateStruct(q)
+	if err == nil || err.(Errors)[0].Error() != "The selected Sort is invalid." {
+		t.Errorf("Expected the client value to be validated, got %v", err)
+	}
+}
+
+func TestDefaultTagErrors(t *testing.T) {
+	type BadRule struct {
+		Sort string `valid:"in=asc|desc" default:"up"`
+	}
+	type BadValue struct {
+		Limit int8 `default:"1000"`
+	}
+	type BadBool struct {
+		Active bool `default:"yes"`
+	}
+	type BadType struct {
+		Tags map[string]string `default:"a"`
+	}
+
+	var tests = []struct {
+		param    interface{}
+		expected string
+	}{
+		{&BadRule{}, `validator: default "up" of BadRule.Sort fails its rules: The selected Sort is invalid.`},
+		{&BadValue{}, `validator: invalid default "1000" of BadValue.Limit: 1000 overflows int8`},
+		{&BadBool{}, `validator: invalid default "yes" of BadBool.Active: "yes" is not a boolean`},
+		{&BadType{}, `validator: invalid default "a" of BadType.Tags: unsupported type map[string]string`},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		if err == nil || !strings.HasPrefix(err.Error(), test.expected) {
+			t.Errorf("Expected %q, got %v", test.expected, err)
+		}
+	}
+
+	if err := ValidateStruct(&BadRule{Sort: "desc"}); err != nil {
+		t.Errorf("Expected a default not to be checked when unused, got %v", err)
+	}
+}
+
+func TestValidateIn(t *testing.T) {
+	var tests = []struct {
+		value    interface{}
+		params   []string
+		expected bool
+	}{
+		{"asc", []string{"asc", "desc"}, true},
+		{"ASC", []string{"asc", "desc"}, false},
+		{2, []string{"1", "2.0"}, true},
+		{1.5, []string{"1", "2"}, false},
+	}
+	for _, test := range tests {
+		actual, err := ValidateIn(test.value, test.params)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ValidateIn(%v, %v) to be %t, got %t %v", test.value, test.params, test.expected, actual, err)
+		}
+		if actual, _ := ValidateNotIn(test.value, test.params); actual == test.expected {
+			t.Errorf("Expected ValidateNotIn(%v, %v) to be %t", test.value, test.params, !test.expected)
+		}
+	}
+	if _, err := ValidateIn([]string{"asc"}, []string{"asc"}); err == nil {
+		t.Error("Expected an error for an unsupported type")
+	}
+}
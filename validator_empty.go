@@ -0,0 +1,96 @@
+package validator
+
+import (
+	"reflect"
+)
+
+// EmptyFunc reports whether a value is empty for required, omitempty and the other required rules.
+type EmptyFunc func(field reflect.Value) bool
+
+// omitMode is the option skipping the rules of a field whose value is absent.
+type omitMode uint8
+
+const (
+	// omitNone runs the rules of every value.
+	omitNone omitMode = iota
+	// omitEmptyMode skips empty values, as the required rule sees them.
+	omitEmptyMode
+	// omitNilMode skips nil pointers, interfaces, maps and slices only.
+	omitNilMode
+	// omitZeroMode skips zero values, decided by an IsZero method where the type has one.
+	omitZeroMode
+)
+
+// zeroer is implemented by types with a meaningful zero value, such as time.Time.
+type zeroer interface {
+	IsZero() bool
+}
+
+var zeroerType = reflect.TypeOf((*zeroer)(nil)).Elem()
+
+// RegisterEmptyFunc registers fn to decide whether values of the types, given as values such as Money{}, are empty.
+// It takes precedence over the IsZero method of the types.
+func (v *Validator) RegisterEmptyFunc(fn EmptyFunc, types ...interface{}) {
+	if v.emptyFuncs == nil {
+		v.emptyFuncs = map[reflect.Type]EmptyFunc{}
+	}
+	for _, t := range types {
+		v.emptyFuncs[reflect.TypeOf(t)] = fn
+	}
+}
+
+// empty reports whether the value is empty, with the EmptyFunc registered for its type or else Empty.
+func (v *Validator) empty(value reflect.Value) bool {
+	if value.IsValid() {
+		if fn, ok := v.emptyFuncs[value.Type()]; ok {
+			return fn(value)
+		}
+	}
+	return Empty(value)
+}
+
+// callIsZero calls the IsZero method of the value, with a value or pointer receiver, and reports whether it has one.
+func callIsZero(v reflect.Value) (zero, ok bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return false, false
+	}
+	if v.Type().Implements(zeroerType) {
+		return v.Interface().(zeroer).IsZero(), true
+	}
+	if v.CanAddr() && reflect.PtrTo(v.Type()).Implements(zeroerType) {
+		return v.Addr().Interface().(zeroer).IsZero(), true
+	}
+	return false, false
+}
+
+// isNil reports whether the value is a nil pointer, interface, map or slice.
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// isZero reports whether the value is the zero value of its type, or zero by its IsZero method.
+func isZero(v reflect.Value) bool {
+	if v.Kind() != reflect.Ptr && v.Kind() != reflect.Interface {
+		if zero, ok := callIsZero(v); ok {
+			return zero
+		}
+	}
+	return v.IsZero()
+}
+
+// omitted reports whether the omit option of the field skips the value.
+func (v *Validator) omitted(f *field, value reflect.Value) bool {
+	switch f.omit {
+	case omitEmptyMode:
+		return v.empty(value)
+	case omitNilMode:
+		return isNil(value)
+	case omitZeroMode:
+		return isZero(value)
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package validator
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type money struct {
+	currency string
+	cents    int64
+}
+
+func (m money) IsZero() bool {
+	return m.cents == 0
+}
+
+func TestEmptyIsZero(t *testing.T) {
+	var tests = []struct {
+		value    interface{}
+		expected bool
+	}{
+		{time.Time{}, true},
+		{time.Now(), false},
+		{netip.Addr{}, true},
+		{netip.MustParseAddr("127.0.0.1"), false},
+		{money{currency: "HKD"}, true},
+		{money{currency: "HKD", cents: 1}, false},
+		{(*time.Time)(nil), true},
+		{&time.Time{}, false},
+	}
+	for _, test := range tests {
+		if actual := Empty(reflect.ValueOf(test.value)); actual != test.expected {
+			t.Errorf("Expected Empty(%#v) to be %t, got %t", test.value, test.expected, actual)
+		}
+	}
+}
+
+func TestRequiredIsZero(t *testing.T) {
+	type Event struct {
+		StartsAt time.Time  `valid:"required"`
+		Host     netip.Addr `valid:"required"`
+		Price    money      `valid:"requiredWith=Paid"`
+		Paid     bool
+	}
+
+	var tests = []struct {
+		param    Event
+		expected string
+	}{
+		{Event{StartsAt: time.Now(), Host: netip.MustParseAddr("::1")}, ""},
+		{Event{Host: netip.MustParseAddr("::1")}, "The StartsAt field is required."},
+		{Event{StartsAt: time.Now()}, "The Host field is required."},
+		{Event{StartsAt: time.Now(), Host: netip.MustParseAddr("::1"), Price: money{currency: "HKD"}, Paid: true}, "The Price field is required when Paid is present."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		actual := ""
+		if err != nil {
+			actual = err.(Errors)[0].Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%+v) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestRegisterEmptyFunc(t *testing.T) {
+	type Order struct {
+		Price money  `valid:"required"`
+		Code  string `valid:"omitempty,size=4"`
+	}
+
+	v := New()
+	v.RegisterEmptyFunc(func(field reflect.Value) bool {
+		return field.Interface().(money).currency == ""
+	}, money{})
+	v.RegisterEmptyFunc(func(field reflect.Value) bool {
+		return field.String() == "" || field.String() == "-"
+	}, "")
+
+	if err := v.ValidateStruct(Order{Price: money{currency: "HKD"}, Code: "-"}, nil, nil); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	err := v.ValidateStruct(Order{Price: money{cents: 100}}, nil, nil)
+	if err == nil || err.(Errors)[0].Error() != "The Price field is required." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := ValidateStruct(Order{Price: money{cents: 100}, Code: "-"}); err == nil {
+		t.Error("Expected the registry not to apply to other Validators")
+	}
+}
+
+func TestOmitModes(t *testing.T) {
+	type Filter struct {
+		Limit  *int      `valid:"omitnil,min=1"`
+		Offset int       `valid:"omitnil,min=1"`
+		Tags   []string  `valid:"omitzero,min=1"`
+		Since  time.Time `valid:"omitzero,required"`
+		Note   string    `valid:"email,attribute=omitempty"`
+	}
+
+	zero := 0
+	var tests = []struct {
+		param    Filter
+		expected string
+	}{
+		{Filter{Offset: 1, Since: time.Now(), Note: "a@example.com"}, ""},
+		{Filter{Offset: 1, Since: time.Now()}, "The omitempty must be a valid email address."},
+		{Filter{Limit: &zero, Offset: 1}, "The Limit must be at least 1."},
+		{Filter{}, "The Offset must be at least 1."},
+		{Filter{Offset: 1, Tags: []string{}}, "The Tags must have at least 1 items."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		actual := ""
+		if err != nil {
+			actual = err.(Errors)[0].Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%+v) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,483 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// imageExtensions is the list of extensions accepted by the image rule.
+var imageExtensions = []string{"jpeg", "png", "gif", "bmp", "svg", "webp"}
+
+var (
+	fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+	bytesType      = reflect.TypeOf([]byte(nil))
+	readerAtType   = reflect.TypeOf((*io.ReaderAt)(nil)).Elem()
+)
+
+// isFileType reports whether values of type t are always validated as a single uploaded file, which
+// *multipart.FileHeader is.
+func isFileType(t reflect.Type) bool {
+	return t == fileHeaderType || (t.Kind() == reflect.Ptr && t.Elem() == fileHeaderType)
+}
+
+// isFileListType reports whether values of type t are always validated as a list of uploaded files,
+// such as []*multipart.FileHeader.
+func isFileListType(t reflect.Type) bool {
+	return (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && isFileType(t.Elem())
+}
+
+// isContentType reports whether values of type t hold the content of a file: []byte or any io.ReaderAt.
+func isContentType(t reflect.Type) bool {
+	if t == bytesType {
+		return true
+	}
+	if t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+		return false
+	}
+	return t.Implements(readerAtType) || (t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(readerAtType))
+}
+
+// contentFileType reports whether a field of type t is validated as a file, or a list of files, because t is []byte
+// or an io.ReaderAt, or a slice of them, and its tag has the file rule. Without the rule, the size rules of a []byte
+// count its elements, and a reader such as *strings.Reader or *os.File is not a file.
+func contentFileType(t reflect.Type, tag string) (file, list bool) {
+	list = (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && t != bytesType && isContentType(t.Elem())
+	if !list && !isContentType(t) {
+		return false, false
+	}
+	for _, option := range strings.Split(tag, ",") {
+		if strings.TrimSpace(option) == "file" {
+			return !list, list
+		}
+	}
+	return false, false
+}
+
+// uploadedFile is a uniform view over the field types accepted by the file rules.
+type uploadedFile struct {
+	Filename string
+	size     int64
+	reader   io.ReaderAt
+	header   *multipart.FileHeader
+}
+
+// newUploadedFile returns the uploadedFile for v, or false if v is not a file.
+func newUploadedFile(v reflect.Value) (*uploadedFile, bool) {
+	if !v.IsValid() {
+		return nil, false
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if !v.CanInterface() || (v.Kind() == reflect.Ptr && v.IsNil()) {
+		return nil, false
+	}
+
+	switch file := v.Interface().(type) {
+	case *multipart.FileHeader:
+		return &uploadedFile{Filename: file.Filename, size: file.Size, header: file}, true
+	case multipart.FileHeader:
+		return &uploadedFile{Filename: file.Filename, size: file.Size, header: &file}, true
+	case []byte:
+		return &uploadedFile{size: int64(len(file)), reader: bytes.NewReader(file)}, true
+	case io.ReaderAt:
+		return &uploadedFile{size: -1, reader: file}, true
+	}
+
+	if v.CanAddr() {
+		if reader, ok := v.Addr().Interface().(io.ReaderAt); ok {
+			return &uploadedFile{size: -1, reader: reader}, true
+		}
+	}
+
+	return nil, false
+}
+
+// open returns a reader over the file content and a function releasing it.
+func (uf *uploadedFile) open() (io.ReaderAt, func(), error) {
+	if uf.header != nil {
+		file, err := uf.header.Open()
+		if err != nil {
+			return nil, nil, err
+		}
+		return file, func() { _ = file.Close() }, nil
+	}
+	return uf.reader, func() {}, nil
+}
+
+// Size returns the size of the file in bytes.
+func (uf *uploadedFile) Size() (int64, error) {
+	if uf.size >= 0 {
+		return uf.size, nil
+	}
+
+	switch r := uf.reader.(type) {
+	case interface{ Size() int64 }:
+		uf.size = r.Size()
+	case interface{ Stat() (os.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, err
+		}
+		uf.size = info.Size()
+	default:
+		var offset int64
+		buf := make([]byte, 32*1024)
+		for {
+			n, err := uf.reader.ReadAt(buf, offset)
+			offset += int64(n)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return 0, err
+			}
+		}
+		uf.size = offset
+	}
+
+	return uf.size, nil
+}
+
+// content reads the whole file.
+func (uf *uploadedFile) content() ([]byte, error) {
+	size, err := uf.Size()
+	if err != nil {
+		return nil, err
+	}
+	r, release, err := uf.open()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return io.ReadAll(io.NewSectionReader(r, 0, size))
+}
+
+// MimeType returns the MIME type of the file detected by DefaultSniffer.
+func (uf *uploadedFile) MimeType() (string, error) {
+	size, err := uf.Size()
+	if err != nil {
+		return "", err
+	}
+
+	reader, closeFunc, err := uf.open()
+	if err != nil {
+		return "", err
+	}
+	defer closeFunc()
+
+	return DefaultSniffer.DetectReader(reader, size)
+}
+
+// mediaTypeEssence strips parameters such as "; charset=utf-8" from a MIME type.
+func mediaTypeEssence(mimeType string) string {
+	if i := strings.IndexByte(mimeType, ';'); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(mimeType))
+}
+
+// matchMimeType reports whether mimeType matches pattern, which may end with a "/*" wildcard.
+func matchMimeType(mimeType, pattern string) bool {
+	pattern = strings.ToLower(pattern)
+	if strings.HasSuffix(pattern, "/*") {
+		return strings.HasPrefix(mimeType, pattern[:len(pattern)-1])
+	}
+	return mimeType == mediaTypeEssence(pattern)
+}
+
+// acceptsMimeType reports whether content detected as mimeType satisfies pattern.
+// Formats that carry no signature of their own are accepted from generic content,
+// such as text/plain for text/csv, when filename has no extension or an extension of that format.
+func acceptsMimeType(mimeType, pattern, filename string) bool {
+	if matchMimeType(mimeType, pattern) {
+		return true
+	}
+
+	target := mediaTypeEssence(pattern)
+	if strings.HasSuffix(target, "/*") {
+		return false
+	}
+	if InString(mimeType, compatibleMimeTypes[target]) {
+		return true
+	}
+	if target == mimeXML && strings.HasSuffix(mimeType, "+xml") {
+		return true
+	}
+	if DefaultSniffer.isStrict(target) || !InString(mimeType, genericMimeTypes(target)) {
+		return false
+	}
+
+	extension := strings.TrimPrefix(path.Ext(filename), ".")
+	return extension == "" || Mimes[strings.ToLower(extension)] == target
+}
+
+// mimeTypesForExtensions resolves extensions through Mimes.
+func mimeTypesForExtensions(extensions []string) ([]string, error) {
+	mimeTypes := make([]string, len(extensions))
+	for i, extension := range extensions {
+		mimeType, ok := Mimes[strings.ToLower(strings.TrimPrefix(extension, "."))]
+		if !ok {
+			return nil, fmt.Errorf("validator: Mimes unsupported type %s", extension)
+		}
+		mimeTypes[i] = mimeType
+	}
+	return mimeTypes, nil
+}
+
+// validateFile is the validation function for validating the field is a file.
+func validateFile(v reflect.Value) (bool, error) {
+	_, ok := newUploadedFile(v)
+	return ok, nil
+}
+
+// ValidateFile is the validation function for validating the field is a file.
+func ValidateFile(i interface{}) bool {
+	valid, _ := validateFile(reflect.ValueOf(i))
+	return valid
+}
+
+// validateMimeTypes is the validation function for validating the file matches one of the given MIME types.
+func validateMimeTypes(v reflect.Value, params []string) (bool, error) {
+	file, ok := newUploadedFile(v)
+	if !ok {
+		return false, fmt.Errorf("validator: Mimetypes unsupported type %s", v.Type())
+	}
+
+	mimeType, err := file.MimeType()
+	if err != nil {
+		return false, err
+	}
+	for _, param := range params {
+		if acceptsMimeType(mimeType, param, file.Filename) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateMimes is the validation function for validating the file has a MIME type corresponding to one of the listed extensions.
+func validateMimes(v reflect.Value, params []string) (bool, error) {
+	mimeTypes, err := mimeTypesForExtensions(params)
+	if err != nil {
+		return false, err
+	}
+	return validateMimeTypes(v, mimeTypes)
+}
+
+// validateImage is the validation function for validating the file is an image (jpeg, png, bmp, gif, svg, or webp).
+func validateImage(v reflect.Value) (bool, error) {
+	return validateMimes(v, imageExtensions)
+}
+
+// ValidateMimeTypes is the validation function for the file must match one of the given MIME types.
+func ValidateMimeTypes(data []byte, mimeTypes []string) bool {
+	mimeType := DefaultSniffer.Detect(data)
+	for _, value := range mimeTypes {
+		if acceptsMimeType(mimeType, value, "") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMimes is the validation function for the file must have a MIME type corresponding to one of the listed extensions.
+func ValidateMimes(data []byte, mimes []string) (bool, error) {
+	mimeTypes, err := mimeTypesForExtensions(mimes)
+	if err != nil {
+		return false, err
+	}
+
+	return ValidateMimeTypes(data, mimeTypes), nil
+}
+
+// ValidateImage is the validation function for the The file under validation must be an image (jpeg, png, bmp, gif, svg, or webp)
+func ValidateImage(data []byte) bool {
+	v, err := ValidateMimes(data, imageExtensions)
+	if err != nil {
+		return false
+	}
+	return v
+}
+
+// isFileSizeRule reports whether rule compares the size of a file.
+func isFileSizeRule(rule string) bool {
+	switch rule {
+	case "between", "min", "max", "size", "gt", "gte", "lt", "lte":
+		return true
+	}
+	return false
+}
+
+// validateFileSize compares the size of the file in kilobytes against params.
+func validateFileSize(file *uploadedFile, rule string, params []string) (bool, error) {
+	if file == nil {
+		return false, fmt.Errorf("validator: %s unsupported file", rule)
+	}
+
+	size, err := file.Size()
+	if err != nil {
+		return false, err
+	}
+	kilobytes := float64(size) / 1024
+
+	if rule == "between" {
+		if len(params) != 2 {
+			return false, fmt.Errorf("validator: Between params length must be 2")
+		}
+		minVal, err := ToFloat(params[0])
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Between rule on file field, min value: %w", err)
+		}
+		maxVal, err := ToFloat(params[1])
+		if err != nil {
+			return false, fmt.Errorf("validator: invalid parameter for Between rule on file field, max value: %w", err)
+		}
+		return ValidateDigitsBetweenFloat64(kilobytes, minVal, maxVal), nil
+	}
+
+	if len(params) != 1 {
+		return false, fmt.Errorf("validator: %s params length must be 1", rule)
+	}
+	p, err := ToFloat(params[0])
+	if err != nil {
+		return false, fmt.Errorf("validator: invalid parameter for %s rule on file field, value: %w", rule, err)
+	}
+
+	operators := map[string]string{"min": ">=", "max": "<=", "size": "==", "gt": ">", "gte": ">=", "lt": "<", "lte": "<="}
+	return compareFloat64(kilobytes, p, operators[rule])
+}
+
+// fileComparands returns the values a field comparison rule compares for a file: its size in kilobytes for the size
+// rules, against another file or a number, and the contents of the two files for same and different. Other values are
+// returned as they are.
+func fileComparands(rule string, value, anotherField reflect.Value) (reflect.Value, reflect.Value) {
+	file, ok := newUploadedFile(value)
+	if !ok {
+		return value, anotherField
+	}
+	other, otherIsFile := newUploadedFile(anotherField)
+
+	switch {
+	case isFileSizeRule(rule):
+		size, err := file.Size()
+		if err != nil {
+			return value, anotherField
+		}
+		if otherIsFile {
+			otherSize, err := other.Size()
+			if err != nil {
+				return value, anotherField
+			}
+			anotherField = reflect.ValueOf(float64(otherSize) / 1024)
+		}
+		return reflect.ValueOf(float64(size) / 1024), anotherField
+	case (rule == "same" || rule == "different") && otherIsFile:
+		content, err := file.content()
+		if err != nil {
+			return value, anotherField
+		}
+		otherContent, err := other.content()
+		if err != nil {
+			return value, anotherField
+		}
+		return reflect.ValueOf(string(content)), reflect.ValueOf(string(otherContent))
+	}
+	return value, anotherField
+}
+
+// fieldErrorValue returns the value of a field error: the filename of a file, or the value as a string.
+func fieldErrorValue(value reflect.Value, f *field) string {
+	if f.isFile || f.isFileList {
+		if file, ok := newUploadedFile(value); ok {
+			return file.Filename
+		}
+	}
+	return ToString(value.Interface())
+}
+
+// validateFileField validates a file or a list of files.
+// Rules of a list apply to every file in it.
+func (v *Validator) validateFileField(value reflect.Value, f *field, o reflect.Value, name, structName string) error {
+	if err := v.checkRequired(value, f, o, name, structName); err != nil {
+		return err
+	}
+	if v.empty(value) {
+		return nil
+	}
+
+	if f.isFileList {
+		for i := 0; i < value.Len(); i++ {
+			if err := v.validateFileRules(value.Index(i), f, o, name+"."+strconv.Itoa(i), structName); err != nil {
+				prependPath(err, PathSegment{Kind: IndexSegment, Index: i})
+				return err
+			}
+		}
+		return nil
+	}
+
+	return v.validateFileRules(value, f, o, name, structName)
+}
+
+// validateFileRules applies the tags of f to a single file.
+func (v *Validator) validateFileRules(value reflect.Value, f *field, o reflect.Value, name, structName string) error {
+	file, ok := newUploadedFile(value)
+	filename := ""
+	if ok {
+		filename = file.Filename
+	}
+
+	for _, tag := range f.validTags {
+		handled, err := v.checkDependentRulesWithStatus(tag, f, value, o, name, structName)
+		if err != nil {
+			return err
+		}
+		if handled {
+			continue
+		}
+
+		isValid := true
+		var funcError error
+
+		if validatefunc, ok := CustomTypeRuleMap.Get(tag.name); ok {
+			isValid = validatefunc(value, o, tag)
+		} else if isFileSizeRule(tag.name) {
+			isValid, funcError = validateFileSize(file, tag.name, tag.params)
+		} else if validfunc, ok := RuleMap[tag.name]; ok {
+			isValid, funcError = validfunc(value)
+		} else if validfunc, ok := ParamRuleMap[tag.name]; ok {
+			isValid, funcError = validfunc(value, tag.params)
+		}
+
+		if !isValid {
+			messageParameters := parseValidatorMessageParameters(tag, o)
+			if tag.name == "dimensions" {
+				messageParameters = append(append(MessageParameters{}, messageParameters...), dimensionsMessageParameters(file)...)
+			}
+			fieldError := v.createFieldError(
+				name, structName, tag.name, tag.messageName,
+				messageParameters,
+				f.attribute, f.defaultAttribute,
+				filename, funcError,
+			)
+			if funcError == nil && isFileSizeRule(tag.name) {
+				if size, err := file.Size(); err == nil {
+					kilobytes := float64(size) / 1024
+					fieldError.detail = ruleDetail(tag, reflect.ValueOf(kilobytes), strconv.FormatFloat(kilobytes, 'f', -1, 64))
+				}
+			}
+			return v.formatsMessages(fieldError)
+		}
+	}
+	return nil
+}
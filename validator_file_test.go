@@ -0,0 +1,322 @@
+package validator
+
+import (
+	"bytes"
+	"mime/multipart"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var (
+	testPNG = append([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"), make([]byte, 64)...)
+	testGIF = append([]byte("GIF89a"), make([]byte, 64)...)
+	testPDF = []byte("%PDF-1.7\n%\xe2\xe3\xcf\xd3\n1 0 obj\n<<>>\nendobj\n")
+)
+
+// newTestFileHeaders builds multipart file headers the same way net/http does for an upload.
+func newTestFileHeaders(t *testing.T, files map[string][]byte) []*multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		part, err := writer.CreateFormFile("file", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := part.Write(files[name]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	form, err := multipart.NewReader(&body, writer.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return form.File["file"]
+}
+
+func TestFileRuleOnFileHeader(t *testing.T) {
+	type Upload struct {
+		Avatar *multipart.FileHeader `valid:"required,image,max=1"`
+	}
+
+	headers := newTestFileHeaders(t, map[string][]byte{
+		"a.png": testPNG,
+		"b.pdf": testPDF,
+		"c.png": append(append([]byte{}, testPNG...), make([]byte, 2048)...),
+	})
+
+	var tests = []struct {
+		param    Upload
+		expected bool
+		message  string
+	}{
+		{Upload{Avatar: headers[0]}, true, ""},
+		{Upload{Avatar: headers[1]}, false, "The Avatar must be an image."},
+		{Upload{Avatar: headers[2]}, false, "The Avatar may not be greater than 1 kilobytes."},
+		{Upload{}, false, "The Avatar field is required."},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Case %d: expected %v, got %v: %v", i, test.expected, actual, err)
+			continue
+		}
+		if err != nil && err.Error() != test.message {
+			t.Errorf("Case %d: expected message %q, got %q", i, test.message, err.Error())
+		}
+	}
+}
+
+func TestFileRuleOnFileHeaderList(t *testing.T) {
+	type Upload struct {
+		Attachments []*multipart.FileHeader `valid:"mimes=png|pdf"`
+	}
+
+	headers := newTestFileHeaders(t, map[string][]byte{
+		"a.png": testPNG,
+		"b.pdf": testPDF,
+		"c.txt": []byte("plain text"),
+	})
+
+	if err := ValidateStruct(&Upload{Attachments: headers[:2]}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := ValidateStruct(&Upload{Attachments: headers})
+	if err == nil {
+		t.Fatal("Expected error for text attachment")
+	}
+	fieldError := err.(Errors)[0].(*FieldError)
+	if fieldError.Name != "Attachments.2" {
+		t.Errorf("Expected error name Attachments.2, got %s", fieldError.Name)
+	}
+	if fieldError.Value != "c.txt" {
+		t.Errorf("Expected error value c.txt, got %s", fieldError.Value)
+	}
+	if fieldError.Message != "The Attachments must be a file of type: png, pdf." {
+		t.Errorf("Unexpected message: %s", fieldError.Message)
+	}
+}
+
+func TestFileRuleOnBytesAndReaderAt(t *testing.T) {
+	type Upload struct {
+		Data   []byte        `valid:"file,mimetypes=image/*,between=0|1"`
+		Reader *bytes.Reader `valid:"file,mimetypes=application/pdf,size=0.0625"`
+	}
+
+	var tests = []struct {
+		param    Upload
+		expected bool
+	}{
+		{Upload{Data: testPNG, Reader: bytes.NewReader(make([]byte, 64))}, false},
+		{Upload{Data: testGIF}, true},
+		{Upload{Data: testPDF}, false},
+		{Upload{Data: append(append([]byte{}, testPNG...), make([]byte, 1024)...)}, false},
+		{Upload{Reader: bytes.NewReader(append(append([]byte{}, testPDF...), make([]byte, 64-len(testPDF))...))}, true},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Case %d: expected %v, got %v: %v", i, test.expected, actual, err)
+		}
+	}
+
+	err := ValidateStruct(&Upload{Data: append(append([]byte{}, testPNG...), make([]byte, 1024)...)})
+	if err == nil || err.Error() != "The Data must be between 0 and 1 kilobytes." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// Without the file rule a reader is not a file, and its size rules are not measured in kilobytes.
+	type Document struct {
+		Body *strings.Reader `valid:"max=3"`
+	}
+	if err := ValidateStruct(&Document{Body: strings.NewReader("more than three")}); err != nil {
+		t.Errorf("Expected a reader without the file rule to keep its previous handling, got %v", err)
+	}
+	if f := cachedTypefields(reflect.TypeOf(Document{})); len(f) != 1 || f[0].isFile {
+		t.Errorf("Expected the reader not to be a file field, got %+v", f)
+	}
+}
+
+func TestFileRuleOnNonFile(t *testing.T) {
+	type Upload struct {
+		Avatar string `valid:"file"`
+		Photo  string `valid:"image"`
+	}
+	err := ValidateStruct(&Upload{Avatar: "avatar.png", Photo: "photo.png"})
+	if err == nil {
+		t.Fatal("Expected error for non-file fields")
+	}
+	errs := err.(Errors)
+	if len(errs) < 2 || errs[0].Error() != "The Avatar must be a file." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateFile(t *testing.T) {
+	var nilHeader *multipart.FileHeader
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{testPNG, true},
+		{bytes.NewReader(testPNG), true},
+		{strings.NewReader("text"), true},
+		{&multipart.FileHeader{Filename: "a.png"}, true},
+		{nilHeader, false},
+		{"a.png", false},
+		{nil, false},
+	}
+	for i, test := range tests {
+		if actual := ValidateFile(test.param); actual != test.expected {
+			t.Errorf("Case %d: expected ValidateFile(%T) to be %v, got %v", i, test.param, test.expected, actual)
+		}
+	}
+}
+
+// readerAtOnly hides the Size method of bytes.Reader.
+type readerAtOnly struct {
+	r *bytes.Reader
+}
+
+func (r readerAtOnly) ReadAt(p []byte, off int64) (int, error) {
+	return r.r.ReadAt(p, off)
+}
+
+func TestUploadedFileSize(t *testing.T) {
+	file, ok := newUploadedFile(reflect.ValueOf(readerAtOnly{r: bytes.NewReader(make([]byte, 70000))}))
+	if !ok {
+		t.Fatal("Expected reader to be a file")
+	}
+	size, err := file.Size()
+	if err != nil || size != 70000 {
+		t.Errorf("Expected size 70000, got %d (%v)", size, err)
+	}
+}
+
+func TestValidateMimes(t *testing.T) {
+	valid, err := ValidateMimes(testPNG, []string{"png"})
+	if err != nil || !valid {
+		t.Errorf("Expected png to match png, got %v (%v)", valid, err)
+	}
+	valid, err = ValidateMimes(testPNG, []string{"jpg", "gif"})
+	if err != nil || valid {
+		t.Errorf("Expected png not to match jpg|gif, got %v (%v)", valid, err)
+	}
+	if _, err := ValidateMimes(testPNG, []string{"unknown"}); err == nil {
+		t.Error("Expected error for unknown extension")
+	}
+	if !ValidateImage(testGIF) {
+		t.Error("Expected gif to be an image")
+	}
+	if !ValidateMimeTypes([]byte("text"), []string{"text/plain"}) {
+		t.Error("Expected text to match text/plain without parameters")
+	}
+}
+
+func TestBytesFileOptIn(t *testing.T) {
+	type Payload struct {
+		Data   []byte   `valid:"max=3"`
+		Upload []byte   `valid:"file,max=1"`
+		Parts  [][]byte `valid:"file,mimes=png"`
+	}
+
+	large := append(append([]byte{}, testPNG...), make([]byte, 2048)...)
+	var tests = []struct {
+		param    Payload
+		expected string
+	}{
+		{Payload{Data: []byte("abc"), Upload: testPNG, Parts: [][]byte{testPNG}}, ""},
+		{Payload{Data: []byte("abcd")}, "The Data may not have more than 3 items."},
+		{Payload{Upload: large}, "The Upload may not be greater than 1 kilobytes."},
+		{Payload{Parts: [][]byte{testPNG, testPDF}}, "The Parts must be a file of type: png."},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(test.param)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("Case %d: unexpected error: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || err.(Errors)[0].Error() != test.expected {
+			t.Errorf("Case %d: expected %q, got %v", i, test.expected, err)
+		}
+	}
+}
+
+func TestFileDependentRules(t *testing.T) {
+	type Upload struct {
+		Avatar *multipart.FileHeader `valid:"lt=Banner,lte=MaxKB"`
+		Banner *multipart.FileHeader
+		Copy   *multipart.FileHeader `valid:"different=Avatar"`
+		Same   *multipart.FileHeader `valid:"same=Avatar"`
+		MaxKB  int
+	}
+
+	headers := newTestFileHeaders(t, map[string][]byte{
+		"a.png": testPNG,
+		"b.png": append(append([]byte{}, testPNG...), make([]byte, 4096)...),
+		"c.png": append(append([]byte{}, testPNG...), 1),
+	})
+	small, big, other := headers[0], headers[1], headers[2]
+
+	var tests = []struct {
+		param    Upload
+		expected string
+	}{
+		{Upload{Avatar: small, Banner: big, Copy: other, Same: small, MaxKB: 1}, ""},
+		{Upload{Avatar: big, Banner: small, MaxKB: 10}, "The Avatar must be less than"},
+		{Upload{Avatar: small, Banner: big, MaxKB: 0}, "The Avatar must be less than or equal"},
+		{Upload{Avatar: small, Banner: big, Copy: small, MaxKB: 1}, "The Copy and Avatar must be different."},
+		{Upload{Avatar: small, Banner: big, Same: other, MaxKB: 1}, "The Same and Avatar must match."},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(test.param)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("Case %d: unexpected error: %v", i, err)
+			}
+			continue
+		}
+		if err == nil || !strings.HasPrefix(err.(Errors)[0].Error(), test.expected) {
+			t.Errorf("Case %d: expected %q, got %v", i, test.expected, err)
+			continue
+		}
+		if fieldErr := err.(Errors)[0].(*FieldError); fieldErr.FuncError != nil || !strings.HasSuffix(fieldErr.Value, ".png") {
+			t.Errorf("Case %d: expected a rule error with the filename, got %+v", i, fieldErr)
+		}
+	}
+}
+
+func TestFileEmptyFunc(t *testing.T) {
+	type Upload struct {
+		Avatar *multipart.FileHeader `valid:"image"`
+	}
+	header := newTestFileHeaders(t, map[string][]byte{"a.png": {}})[0]
+
+	if err := ValidateStruct(Upload{Avatar: header}); err == nil {
+		t.Error("Expected an empty upload to fail the image rule")
+	}
+	v := New()
+	v.RegisterEmptyFunc(func(field reflect.Value) bool {
+		return field.IsNil() || field.Interface().(*multipart.FileHeader).Size == 0
+	}, (*multipart.FileHeader)(nil))
+	if err := v.ValidateStruct(Upload{Avatar: header}, nil, nil); err != nil {
+		t.Errorf("Expected the registered EmptyFunc to skip the upload, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package validator
+
+import (
+	"strings"
+	"time"
+)
+
+// cnResidentIDWeights are the ISO 7064 MOD 11-2 weights of the first 17 digits of a resident identity card number.
+var cnResidentIDWeights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+// cnSocialCreditChars are the characters of a unified social credit code in the order of their values.
+const cnSocialCreditChars = "0123456789ABCDEFGHJKLMNPQRTUWXY"
+
+// cnSocialCreditWeights are the weights of the first 17 characters of a unified social credit code.
+var cnSocialCreditWeights = [17]int{1, 3, 9, 27, 19, 26, 16, 17, 20, 29, 25, 13, 8, 24, 10, 30, 28}
+
+// ValidateCNResidentID check if the string is an 18-digit resident identity card number of mainland China, with a birth
+// date that exists and is not in the future, and a valid ISO 7064 MOD 11-2 check character, 0-9 or X. Empty string is valid.
+func ValidateCNResidentID(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if !rxCNResidentID.MatchString(str) {
+		return false
+	}
+
+	birth, err := time.Parse("20060102", str[6:14])
+	if err != nil || birth.Year() < 1900 || birth.After(time.Now()) {
+		return false
+	}
+
+	sum := 0
+	for i, weight := range cnResidentIDWeights {
+		sum += int(str[i]-'0') * weight
+	}
+	return str[17] == "10X98765432"[sum%11]
+}
+
+// ValidateHKID check if the string is a Hong Kong Identity Card number, one or two letters, six digits and a check
+// digit, 0-9 or A, with or without parentheses, such as A123456(3). Empty string is valid.
+func ValidateHKID(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if !rxHKID.MatchString(str) {
+		return false
+	}
+
+	id := strings.NewReplacer("(", "", ")", "").Replace(str)
+	// A single letter is preceded by a space, which counts as 36.
+	sum := 0
+	if len(id) == 8 {
+		sum = 36 * 9
+		id = " " + id
+	}
+	for i := 0; i < 8; i++ {
+		c := id[i]
+		switch {
+		case c == ' ':
+		case 'A' <= c && c <= 'Z':
+			sum += int(c-'A'+10) * (9 - i)
+		default:
+			sum += int(c-'0') * (9 - i)
+		}
+	}
+	return id[8] == "0A987654321"[sum%11]
+}
+
+// ValidateCNSocialCreditCode check if the string is an 18-character Unified Social Credit Code of an organisation of
+// mainland China, as defined by GB 32100-2015, with a valid check character. Empty string is valid.
+func ValidateCNSocialCreditCode(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if !rxCNSocialCredit.MatchString(str) {
+		return false
+	}
+
+	sum := 0
+	for i, weight := range cnSocialCreditWeights {
+		sum += strings.IndexByte(cnSocialCreditChars, str[i]) * weight
+	}
+	return str[17] == cnSocialCreditChars[(31-sum%31)%31]
+}
+
+// ValidateCNMobile check if the string is a mobile phone number of mainland China, such as 138 0013 8000 or
+// +86 138 0013 8000. Empty string is valid.
+func ValidateCNMobile(str string) bool {
+	return ValidateMobilePhone(str, "CN")
+}
+
+// ValidateCNLandline check if the string is a landline phone number of mainland China with its area code, such as
+// 010-12345678 or +86 755 1234567. Empty string is valid.
+func ValidateCNLandline(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxCNLandline.MatchString(str)
+}
@@ -0,0 +1,55 @@
+package validator
+
+import "testing"
+
+func TestValidateIdentity(t *testing.T) {
+	var tests = []struct {
+		param    string
+		validate func(string) bool
+		expected bool
+	}{
+		{"", ValidateCNResidentID, true},
+		{"11010519491231002X", ValidateCNResidentID, true},
+		{"440524198001010013", ValidateCNResidentID, true},
+		{"440524188001010014", ValidateCNResidentID, false},
+		{"11010519491231002x", ValidateCNResidentID, false},
+		{"110105194912310021", ValidateCNResidentID, false},
+		{"110105194902300027", ValidateCNResidentID, false},
+		{"110105299912310023", ValidateCNResidentID, false},
+		{"11010519491231002", ValidateCNResidentID, false},
+		{"A123456(3)", ValidateHKID, true},
+		{"A1234563", ValidateHKID, true},
+		{"AB987654(3)", ValidateHKID, true},
+		{"A123456(4)", ValidateHKID, false},
+		{"A123456(3", ValidateHKID, false},
+		{"a123456(3)", ValidateHKID, false},
+		{"ABC123456(3)", ValidateHKID, false},
+		{"91350100M000100Y43", ValidateCNSocialCreditCode, true},
+		{"91350100M000100Y44", ValidateCNSocialCreditCode, false},
+		{"91350100M000100I43", ValidateCNSocialCreditCode, false},
+		{"91350100M000100Y4", ValidateCNSocialCreditCode, false},
+		{"13800138000", ValidateCNMobile, true},
+		{"+86 138 0013 8000", ValidateCNMobile, true},
+		{"12800138000", ValidateCNMobile, false},
+		{"010 1234 5678", ValidateCNMobile, false},
+		{"010-62345678", ValidateCNLandline, true},
+		{"0755 2345678", ValidateCNLandline, true},
+		{"+86 755 2345678", ValidateCNLandline, true},
+		{"010-02345678", ValidateCNLandline, false},
+		{"13800138000", ValidateCNLandline, false},
+		{"12345678", ValidateCNLandline, false},
+	}
+	for _, test := range tests {
+		if actual := test.validate(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	type Resident struct {
+		ID string `valid:"cnResidentId"`
+	}
+	err := ValidateStruct(&Resident{ID: "110105194912310021"})
+	if err == nil || err.Error() != "The ID must be a valid resident identity card number." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
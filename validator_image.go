@@ -0,0 +1,256 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// MaxImagePixels is the largest number of pixels, width times height, accepted by the dimensions rule.
+// Images above it are rejected from their header, before any pixel data is decoded.
+// A tag may lower it with the maxPixels constraint.
+var MaxImagePixels int64 = 100 * 1000 * 1000
+
+// imageFormat reads the dimensions of images whose content starts with magic.
+type imageFormat struct {
+	magic        string
+	decodeConfig func(io.Reader) (image.Config, error)
+}
+
+// imageFormats are the formats read by the dimensions rule. Other formats
+// registered with image.RegisterFormat are read through image.DecodeConfig.
+var imageFormats = struct {
+	formats []imageFormat
+	sync.RWMutex
+}{
+	formats: []imageFormat{
+		{"\x89PNG\r\n\x1a\n", png.DecodeConfig},
+		{"\xff\xd8", jpeg.DecodeConfig},
+		{"GIF8?a", gif.DecodeConfig},
+	},
+}
+
+// RegisterImageFormat registers a function reading the dimensions of an image format for the dimensions rule.
+// Magic is the prefix of the content, in which "?" matches any byte, as in image.RegisterFormat.
+func RegisterImageFormat(magic string, decodeConfig func(io.Reader) (image.Config, error)) {
+	imageFormats.Lock()
+	defer imageFormats.Unlock()
+	imageFormats.formats = append(imageFormats.formats, imageFormat{magic: magic, decodeConfig: decodeConfig})
+}
+
+// matchMagic reports whether header starts with magic, in which "?" matches any byte.
+func matchMagic(magic string, header []byte) bool {
+	if len(header) < len(magic) {
+		return false
+	}
+	for i := 0; i < len(magic); i++ {
+		if magic[i] != '?' && magic[i] != header[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeImageConfig reads the dimensions of an image without decoding its pixel data.
+func decodeImageConfig(r io.ReaderAt, size int64) (image.Config, error) {
+	imageFormats.RLock()
+	defer imageFormats.RUnlock()
+
+	headerSize := 16
+	for _, format := range imageFormats.formats {
+		if len(format.magic) > headerSize {
+			headerSize = len(format.magic)
+		}
+	}
+	header := make([]byte, headerSize)
+	n, err := r.ReadAt(header, 0)
+	if err != nil && err != io.EOF {
+		return image.Config{}, err
+	}
+	header = header[:n]
+
+	decodeConfig := image.DecodeConfig
+	for _, format := range imageFormats.formats {
+		if matchMagic(format.magic, header) {
+			decodeConfig = func(r io.Reader) (image.Config, string, error) {
+				config, err := format.decodeConfig(r)
+				return config, "", err
+			}
+			break
+		}
+	}
+
+	config, _, err := decodeConfig(io.NewSectionReader(r, 0, size))
+	return config, err
+}
+
+// ImageConfig returns the dimensions of the image file.
+func (uf *uploadedFile) ImageConfig() (image.Config, error) {
+	size, err := uf.Size()
+	if err != nil {
+		return image.Config{}, err
+	}
+
+	reader, closeFunc, err := uf.open()
+	if err != nil {
+		return image.Config{}, err
+	}
+	defer closeFunc()
+
+	return decodeImageConfig(reader, size)
+}
+
+// dimensionConstraints are the constraints of a dimensions tag, such as minWidth:100.
+type dimensionConstraints struct {
+	width, height        int64
+	minWidth, maxWidth   int64
+	minHeight, maxHeight int64
+	maxPixels            int64
+	ratio                float64
+}
+
+// parseDimensionConstraints parses params such as "minWidth:100" and "ratio:16/9".
+func parseDimensionConstraints(params []string) (*dimensionConstraints, error) {
+	constraints := &dimensionConstraints{maxPixels: MaxImagePixels}
+	for _, param := range params {
+		name, value, ok := strings.Cut(param, ":")
+		if !ok {
+			return nil, fmt.Errorf("validator: Dimensions invalid parameter %s", param)
+		}
+
+		if name == "ratio" {
+			ratio, err := parseRatio(value)
+			if err != nil {
+				return nil, err
+			}
+			constraints.ratio = ratio
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("validator: Dimensions invalid parameter %s", param)
+		}
+		switch name {
+		case "width":
+			constraints.width = n
+		case "height":
+			constraints.height = n
+		case "minWidth":
+			constraints.minWidth = n
+		case "maxWidth":
+			constraints.maxWidth = n
+		case "minHeight":
+			constraints.minHeight = n
+		case "maxHeight":
+			constraints.maxHeight = n
+		case "maxPixels":
+			if n < constraints.maxPixels {
+				constraints.maxPixels = n
+			}
+		default:
+			return nil, fmt.Errorf("validator: Dimensions unknown constraint %s", name)
+		}
+	}
+	return constraints, nil
+}
+
+// parseRatio parses a ratio written as "16/9" or "1.5".
+func parseRatio(value string) (float64, error) {
+	numerator, denominator, isFraction := strings.Cut(value, "/")
+	n, err := strconv.ParseFloat(numerator, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("validator: Dimensions invalid ratio %s", value)
+	}
+	if !isFraction {
+		return n, nil
+	}
+	d, err := strconv.ParseFloat(denominator, 64)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("validator: Dimensions invalid ratio %s", value)
+	}
+	return n / d, nil
+}
+
+// check reports whether an image of config satisfies the constraints.
+func (c *dimensionConstraints) check(config image.Config) bool {
+	width, height := int64(config.Width), int64(config.Height)
+	if width <= 0 || height <= 0 || width*height > c.maxPixels {
+		return false
+	}
+
+	if (c.width > 0 && width != c.width) ||
+		(c.height > 0 && height != c.height) ||
+		(c.minWidth > 0 && width < c.minWidth) ||
+		(c.maxWidth > 0 && width > c.maxWidth) ||
+		(c.minHeight > 0 && height < c.minHeight) ||
+		(c.maxHeight > 0 && height > c.maxHeight) {
+		return false
+	}
+
+	if c.ratio > 0 {
+		// Allow the image to be a pixel off the ratio in either direction.
+		w, h := float64(width), float64(height)
+		if math.Abs(w-c.ratio*h) > 1 && math.Abs(h-w/c.ratio) > 1 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// validateDimensions is the validation function for validating the image file matches the dimension constraints.
+func validateDimensions(v reflect.Value, params []string) (bool, error) {
+	constraints, err := parseDimensionConstraints(params)
+	if err != nil {
+		return false, err
+	}
+
+	file, ok := newUploadedFile(v)
+	if !ok {
+		return false, fmt.Errorf("validator: Dimensions unsupported type %s", v.Type())
+	}
+
+	config, err := file.ImageConfig()
+	if err != nil {
+		return false, nil
+	}
+	return constraints.check(config), nil
+}
+
+// ValidateDimensions is the validation function for the image must match the dimension constraints,
+// such as minWidth:100, maxHeight:2000 or ratio:16/9.
+func ValidateDimensions(data []byte, params []string) (bool, error) {
+	constraints, err := parseDimensionConstraints(params)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := decodeImageConfig(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return false, nil
+	}
+	return constraints.check(config), nil
+}
+
+// dimensionsMessageParameters returns the Width and Height of the image file for the dimensions message.
+// Both are 0 when the file is not an image.
+func dimensionsMessageParameters(file *uploadedFile) MessageParameters {
+	var config image.Config
+	if file != nil {
+		config, _ = file.ImageConfig()
+	}
+	return MessageParameters{
+		{Key: "Width", Value: strconv.Itoa(config.Width)},
+		{Key: "Height", Value: strconv.Itoa(config.Height)},
+	}
+}
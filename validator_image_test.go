@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"testing"
+)
+
+func encodeTestImage(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	var err error
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// pngHeader returns a PNG signature and IHDR chunk claiming the given dimensions, without pixel data.
+func pngHeader(width, height uint32) []byte {
+	chunk := make([]byte, 17)
+	copy(chunk, "IHDR")
+	binary.BigEndian.PutUint32(chunk[4:], width)
+	binary.BigEndian.PutUint32(chunk[8:], height)
+	chunk[12] = 8
+	chunk[13] = 6
+
+	data := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0d")
+	data = append(data, chunk...)
+	return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(chunk))
+}
+
+func TestValidateDimensions(t *testing.T) {
+	var tests = []struct {
+		data     []byte
+		params   []string
+		expected bool
+	}{
+		{encodeTestImage(t, "png", 160, 90), []string{"ratio:16/9"}, true},
+		{encodeTestImage(t, "png", 161, 90), []string{"ratio:16/9"}, true},
+		{encodeTestImage(t, "png", 170, 90), []string{"ratio:16/9"}, false},
+		{encodeTestImage(t, "jpeg", 120, 80), []string{"minWidth:100", "maxWidth:200"}, true},
+		{encodeTestImage(t, "jpeg", 80, 80), []string{"minWidth:100"}, false},
+		{encodeTestImage(t, "gif", 30, 40), []string{"width:30", "height:40"}, true},
+		{encodeTestImage(t, "gif", 30, 40), []string{"minHeight:50"}, false},
+		{encodeTestImage(t, "gif", 30, 40), []string{"maxHeight:30"}, false},
+		{encodeTestImage(t, "png", 10, 20), []string{"ratio:0.5"}, true},
+		{encodeTestImage(t, "png", 100, 100), []string{"maxPixels:9999"}, false},
+		{pngHeader(100000, 100000), []string{"minWidth:1"}, false},
+		{pngHeader(1000, 1000), []string{"minWidth:1"}, true},
+		{testPDF, []string{"minWidth:1"}, false},
+	}
+	for i, test := range tests {
+		actual, err := ValidateDimensions(test.data, test.params)
+		if err != nil {
+			t.Errorf("Case %d: unexpected error: %v", i, err)
+		}
+		if actual != test.expected {
+			t.Errorf("Case %d: expected ValidateDimensions(%v) to be %v, got %v", i, test.params, test.expected, actual)
+		}
+	}
+
+	for _, params := range [][]string{{"minWidth"}, {"minWidth:abc"}, {"depth:3"}, {"ratio:16/0"}} {
+		if _, err := ValidateDimensions(testPNG, params); err == nil {
+			t.Errorf("Expected error for %v", params)
+		}
+	}
+}
+
+func TestDimensionsRule(t *testing.T) {
+	type Upload struct {
+		Banner []byte `valid:"file,dimensions=minWidth:100|ratio:2/1"`
+	}
+
+	if err := ValidateStruct(&Upload{Banner: encodeTestImage(t, "png", 200, 100)}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := ValidateStruct(&Upload{Banner: encodeTestImage(t, "png", 50, 25)})
+	if err == nil || err.Error() != "The Banner has invalid image dimensions (50x25)." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err = ValidateStruct(&Upload{Banner: testPDF})
+	if err == nil || err.Error() != "The Banner has invalid image dimensions (0x0)." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	fieldError := err.(Errors)[0].(*FieldError)
+	for _, parameter := range fieldError.MessageParameters {
+		if parameter.Key == "MinWidth" && parameter.Value != "100" {
+			t.Errorf("Expected MinWidth parameter 100, got %s", parameter.Value)
+		}
+	}
+
+	type Avatar struct {
+		Image []byte `valid:"file,dimensions=width:100|height:100"`
+	}
+	err = ValidateStruct(&Avatar{Image: encodeTestImage(t, "png", 50, 25)})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	parameters := map[string]string{}
+	for _, parameter := range err.(Errors)[0].(*FieldError).MessageParameters {
+		parameters[parameter.Key] = parameter.Value
+	}
+	if parameters["ExactWidth"] != "100" || parameters["ExactHeight"] != "100" || parameters["Width"] != "50" || parameters["Height"] != "25" {
+		t.Errorf("Expected the constraints and the actual dimensions, got %v", parameters)
+	}
+}
+
+func TestRegisterImageFormat(t *testing.T) {
+	imageFormats.RLock()
+	formats := imageFormats.formats
+	imageFormats.RUnlock()
+	t.Cleanup(func() {
+		imageFormats.Lock()
+		imageFormats.formats = formats
+		imageFormats.Unlock()
+	})
+
+	data := []byte("TESTIMG\x00")
+	if valid, _ := ValidateDimensions(data, []string{"width:7"}); valid {
+		t.Fatal("Expected unknown format to fail")
+	}
+
+	RegisterImageFormat("TESTIMG", func(r io.Reader) (image.Config, error) {
+		header := make([]byte, 8)
+		if _, err := io.ReadFull(r, header); err != nil {
+			return image.Config{}, errors.New("short header")
+		}
+		return image.Config{Width: 7, Height: 3}, nil
+	})
+	if valid, err := ValidateDimensions(data, []string{"width:7", "height:3"}); err != nil || !valid {
+		t.Errorf("Expected registered format to be read, got %v (%v)", valid, err)
+	}
+
+	long := []byte("LONGMAGIC-IMAGE-FORMAT\x00")
+	RegisterImageFormat("LONGMAGIC-IMAGE-FORMAT", func(r io.Reader) (image.Config, error) {
+		return image.Config{Width: 9, Height: 9}, nil
+	})
+	if valid, err := ValidateDimensions(long, []string{"width:9"}); err != nil || !valid {
+		t.Errorf("Expected a format with a magic longer than 16 bytes to be read, got %v (%v)", valid, err)
+	}
+}
@@ -0,0 +1,257 @@
+package validator
+
+import (
+	_ "embed" // the ISO and IBAN tables are embedded from data
+	"encoding/csv"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:generate go run ./internal/gendata
+
+var (
+	//go:embed data/iso3166-1.csv
+	iso3166Data string
+	//go:embed data/iso4217.csv
+	iso4217Data string
+	//go:embed data/iso639.txt
+	iso639Data string
+	//go:embed data/iso15924.txt
+	iso15924Data string
+	//go:embed data/iban.csv
+	ibanData string
+)
+
+// isoTables are the code tables parsed from the embedded data on first use. Currencies map the alphabetic code
+// to the minor units, or -1 when decimals do not apply, as for gold.
+type isoTables struct {
+	countries2        map[string]bool
+	countries3        map[string]bool
+	countriesNumeric  map[string]bool
+	currencies        map[string]int
+	currenciesNumeric map[string]bool
+	languages         map[string]bool
+	scripts           map[string]bool
+	ibanLengths       map[string]int
+}
+
+var (
+	isoTablesOnce sync.Once
+	isoTablesData *isoTables
+)
+
+// readTable parses embedded CSV data, skipping the generated comment and the header row.
+// The tables ship with the package, so a malformed table is a bug of the package.
+func readTable(name, data string) [][]string {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.Comment = '#'
+	records, err := reader.ReadAll()
+	if err != nil || len(records) == 0 {
+		panic(fmt.Sprintf("validator: invalid embedded table %s: %v", name, err))
+	}
+	return records[1:]
+}
+
+// readList parses embedded data of one code per line, skipping comments.
+func readList(data string) map[string]bool {
+	codes := map[string]bool{}
+	for _, line := range strings.Split(data, "\n") {
+		if line = strings.TrimSpace(line); line != "" && line[0] != '#' {
+			codes[strings.ToLower(line)] = true
+		}
+	}
+	return codes
+}
+
+// loadISOTables returns the code tables, parsing them on first use.
+func loadISOTables() *isoTables {
+	isoTablesOnce.Do(func() {
+		t := &isoTables{
+			countries2:        map[string]bool{},
+			countries3:        map[string]bool{},
+			countriesNumeric:  map[string]bool{},
+			currencies:        map[string]int{},
+			currenciesNumeric: map[string]bool{},
+			languages:         readList(iso639Data),
+			scripts:           readList(iso15924Data),
+			ibanLengths:       map[string]int{},
+		}
+		for _, record := range readTable("iso3166-1", iso3166Data) {
+			t.countries2[record[0]] = true
+			t.countries3[record[1]] = true
+			t.countriesNumeric[record[2]] = true
+		}
+		for _, record := range readTable("iso4217", iso4217Data) {
+			minorUnits := -1
+			if record[2] != "" {
+				minorUnits, _ = strconv.Atoi(record[2])
+			}
+			t.currencies[record[0]] = minorUnits
+			t.currenciesNumeric[record[1]] = true
+		}
+		for _, record := range readTable("iban", ibanData) {
+			length, _ := strconv.Atoi(record[1])
+			t.ibanLengths[record[0]] = length
+		}
+		isoTablesData = t
+	})
+	return isoTablesData
+}
+
+// ValidateCountry2 check if the string is an ISO 3166-1 alpha-2 country code, such as US. Empty string is valid.
+func ValidateCountry2(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return loadISOTables().countries2[str]
+}
+
+// ValidateCountry3 check if the string is an ISO 3166-1 alpha-3 country code, such as USA. Empty string is valid.
+func ValidateCountry3(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return loadISOTables().countries3[str]
+}
+
+// ValidateCountryNumeric check if the string is an ISO 3166-1 numeric country code of three digits, such as 840. Empty string is valid.
+func ValidateCountryNumeric(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return loadISOTables().countriesNumeric[str]
+}
+
+// validateCountryNumeric is the validation function for validating the string or integer is an ISO 3166-1 numeric country code.
+func validateCountryNumeric(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return ValidateCountryNumeric(v.String()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() >= 0 && ValidateCountryNumeric(fmt.Sprintf("%03d", v.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return ValidateCountryNumeric(fmt.Sprintf("%03d", v.Uint())), nil
+	default:
+		return false, fmt.Errorf("validator: CountryNumeric unsupported type %s", v.Type())
+	}
+}
+
+// ValidateCurrency check if the string is an ISO 4217 currency code, alphabetic such as USD or numeric such as 840.
+// Empty string is valid.
+func ValidateCurrency(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	tables := loadISOTables()
+	_, ok := tables.currencies[str]
+	return ok || tables.currenciesNumeric[str]
+}
+
+// ValidateCurrencyAmount check if the string is a decimal amount with no more decimal places than the minor units of the
+// ISO 4217 currency, such as 2 for USD and 0 for JPY. Empty string is valid.
+func ValidateCurrencyAmount(amount string, currency string) (bool, error) {
+	minorUnits, ok := loadISOTables().currencies[currency]
+	if !ok {
+		return false, fmt.Errorf("validator: CurrencyAmount unknown currency %s", currency)
+	}
+	if IsNull(amount) {
+		return true, nil
+	}
+
+	if amount[0] == '-' || amount[0] == '+' {
+		amount = amount[1:]
+	}
+	integer, fraction, hasFraction := strings.Cut(amount, ".")
+	if integer == "" || !isDigits(integer) || (hasFraction && (fraction == "" || !isDigits(fraction))) {
+		return false, nil
+	}
+	return minorUnits < 0 || len(fraction) <= minorUnits, nil
+}
+
+// isDigits reports whether str consists of ASCII digits only.
+func isDigits(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// validateCurrencyAmount is the validation function for validating the amount has no more decimal places than the
+// currency of params allows. Strings, integers and floats are supported.
+func validateCurrencyAmount(v reflect.Value, params []string) (bool, error) {
+	if len(params) != 1 {
+		return false, fmt.Errorf("validator: CurrencyAmount params length must be 1")
+	}
+
+	var amount string
+	switch v.Kind() {
+	case reflect.String:
+		amount = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		amount = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		amount = strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32:
+		amount = strconv.FormatFloat(v.Float(), 'f', -1, 32)
+	case reflect.Float64:
+		amount = strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return false, fmt.Errorf("validator: CurrencyAmount unsupported type %s", v.Type())
+	}
+	return ValidateCurrencyAmount(amount, params[0])
+}
+
+// ValidateIBAN check if the string is an IBAN with the length registered for its country and valid mod-97 check digits.
+// The spaces of the print format are ignored. Empty string is valid.
+func ValidateIBAN(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	iban := strings.ReplaceAll(str, " ", "")
+	if len(iban) < 5 {
+		return false
+	}
+	length, ok := loadISOTables().ibanLengths[iban[:2]]
+	if !ok || len(iban) != length || !isDigits(iban[2:4]) {
+		return false
+	}
+
+	// Move the country code and check digits to the end and read letters as 10 to 35.
+	remainder := 0
+	for _, c := range iban[4:] + iban[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		default:
+			return false
+		}
+	}
+	return remainder == 1
+}
+
+// ValidateBIC check if the string is a BIC (SWIFT code) of 8 or 11 characters: a bank code, an ISO 3166-1 country code,
+// a location code and an optional branch code, such as DEUTDEFF500. Empty string is valid.
+func ValidateBIC(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if len(str) != 8 && len(str) != 11 {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		isLetter := 'A' <= c && c <= 'Z'
+		if !isLetter && (i < 6 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	country := str[4:6]
+	return loadISOTables().countries2[country] || country == "XK"
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateISOCodes(t *testing.T) {
+	var tests = []struct {
+		param    string
+		validate func(string) bool
+		expected bool
+	}{
+		{"", ValidateCountry2, true},
+		{"US", ValidateCountry2, true},
+		{"HK", ValidateCountry2, true},
+		{"us", ValidateCountry2, false},
+		{"UK", ValidateCountry2, false},
+		{"USA", ValidateCountry3, true},
+		{"HKG", ValidateCountry3, true},
+		{"US", ValidateCountry3, false},
+		{"840", ValidateCountryNumeric, true},
+		{"004", ValidateCountryNumeric, true},
+		{"4", ValidateCountryNumeric, false},
+		{"999", ValidateCountryNumeric, false},
+		{"USD", ValidateCurrency, true},
+		{"HKD", ValidateCurrency, true},
+		{"840", ValidateCurrency, true},
+		{"usd", ValidateCurrency, false},
+		{"ABC", ValidateCurrency, false},
+		{"GB82 WEST 1234 5698 7654 32", ValidateIBAN, true},
+		{"GB82WEST12345698765432", ValidateIBAN, true},
+		{"DE89370400440532013000", ValidateIBAN, true},
+		{"NO9386011117947", ValidateIBAN, true},
+		{"GB82WEST12345698765433", ValidateIBAN, false},
+		{"GB82WEST1234569876543", ValidateIBAN, false},
+		{"US82WEST12345698765432", ValidateIBAN, false},
+		{"gb82west12345698765432", ValidateIBAN, false},
+		{"DEUTDEFF", ValidateBIC, true},
+		{"DEUTDEFF500", ValidateBIC, true},
+		{"HSBCHKHHHKH", ValidateBIC, true},
+		{"DEUTZZFF", ValidateBIC, false},
+		{"DEU1DEFF", ValidateBIC, false},
+		{"DEUTDEFF50", ValidateBIC, false},
+		{"deutdeff", ValidateBIC, false},
+		{"en", ValidateLanguage, true},
+		{"EN-us", ValidateLanguage, true},
+		{"zh-Hant-HK", ValidateLanguage, true},
+		{"yue-HK", ValidateLanguage, true},
+		{"zh-yue-HK", ValidateLanguage, true},
+		{"es-419", ValidateLanguage, true},
+		{"sl-rozaj-biske", ValidateLanguage, true},
+		{"de-CH-1901", ValidateLanguage, true},
+		{"en-US-u-ca-gregory-x-private", ValidateLanguage, true},
+		{"x-whatever", ValidateLanguage, true},
+		{"i-klingon", ValidateLanguage, true},
+		{"qaa-Qaaa-QM", ValidateLanguage, true},
+		{"xx", ValidateLanguage, false},
+		{"en-Abcd", ValidateLanguage, false},
+		{"en-UK", ValidateLanguage, false},
+		{"de-419-DE", ValidateLanguage, false},
+		{"en-a", ValidateLanguage, false},
+		{"en-u-ca-u-nu", ValidateLanguage, false},
+		{"sl-rozaj-rozaj", ValidateLanguage, false},
+		{"en--US", ValidateLanguage, false},
+		{"en_US", ValidateLanguage, false},
+		{"x", ValidateLanguage, false},
+		{"english", ValidateLanguage, false},
+	}
+	for _, test := range tests {
+		if actual := test.validate(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	for _, code := range []interface{}{840, uint16(344), "344"} {
+		if valid, err := validateCountryNumeric(reflect.ValueOf(code)); err != nil || !valid {
+			t.Errorf("Expected %v to be a numeric country code, got %v (%v)", code, valid, err)
+		}
+	}
+	if valid, _ := validateCountryNumeric(reflect.ValueOf(-840)); valid {
+		t.Error("Expected negative numeric country code to be invalid")
+	}
+}
+
+func TestValidateCurrencyAmount(t *testing.T) {
+	var tests = []struct {
+		amount   string
+		currency string
+		expected bool
+	}{
+		{"", "USD", true},
+		{"10", "USD", true},
+		{"10.5", "USD", true},
+		{"-10.55", "USD", true},
+		{"10.555", "USD", false},
+		{"10.550", "USD", false},
+		{"1000", "JPY", true},
+		{"1000.5", "JPY", false},
+		{"1.125", "KWD", true},
+		{"1.1255", "KWD", false},
+		{"1.12345", "XAU", true},
+		{"10.", "USD", false},
+		{".5", "USD", false},
+		{"1e3", "USD", false},
+		{"+-1", "USD", false},
+	}
+	for _, test := range tests {
+		actual, err := ValidateCurrencyAmount(test.amount, test.currency)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateCurrencyAmount(%q, %q) to be %v, got %v", test.amount, test.currency, test.expected, actual)
+		}
+	}
+	if _, err := ValidateCurrencyAmount("1", "ABC"); err == nil {
+		t.Error("Expected error for unknown currency")
+	}
+
+	type Invoice struct {
+		Total float64 `valid:"currencyAmount=USD"`
+	}
+	if err := ValidateStruct(&Invoice{Total: 19.99}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Invoice{Total: 19.999})
+	if err == nil || err.Error() != "The Total must be a valid amount in USD." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
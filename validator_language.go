@@ -0,0 +1,158 @@
+package validator
+
+import "strings"
+
+// grandfatheredLanguageTags are the tags of RFC 5646 that predate its syntax and are valid as a whole.
+var grandfatheredLanguageTags = map[string]bool{
+	"en-gb-oed": true, "i-ami": true, "i-bnn": true, "i-default": true, "i-enochian": true, "i-hak": true,
+	"i-klingon": true, "i-lux": true, "i-mingo": true, "i-navajo": true, "i-pwn": true, "i-tao": true,
+	"i-tay": true, "i-tsu": true, "sgn-be-fr": true, "sgn-be-nl": true, "sgn-ch-de": true,
+	"art-lojban": true, "cel-gaulish": true, "no-bok": true, "no-nyn": true, "zh-guoyu": true,
+	"zh-hakka": true, "zh-min": true, "zh-min-nan": true, "zh-xiang": true,
+}
+
+// isAlpha reports whether str consists of ASCII letters only.
+func isAlpha(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if c := str[i] | 0x20; c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphaNum reports whether str consists of ASCII letters and digits only.
+func isAlphaNum(str string) bool {
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; !('0' <= c && c <= '9') && !isAlpha(str[i:i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLanguageSubtag reports whether the lowercase subtag is an ISO 639 code or in the private use range qaa-qtz.
+func isLanguageSubtag(subtag string, tables *isoTables) bool {
+	if len(subtag) == 3 && subtag >= "qaa" && subtag <= "qtz" {
+		return true
+	}
+	return tables.languages[subtag]
+}
+
+// isScriptSubtag reports whether the lowercase subtag is an ISO 15924 code or in the private use range Qaaa-Qabx.
+func isScriptSubtag(subtag string, tables *isoTables) bool {
+	if subtag >= "qaaa" && subtag <= "qabx" {
+		return true
+	}
+	return tables.scripts[subtag]
+}
+
+// isRegionSubtag reports whether the lowercase subtag is an ISO 3166-1 code, a UN M.49 code of three digits,
+// one of the codes EU, EZ and UN of the IANA registry, or in a private use range.
+func isRegionSubtag(subtag string, tables *isoTables) bool {
+	if len(subtag) == 3 {
+		return isDigits(subtag)
+	}
+	region := strings.ToUpper(subtag)
+	switch {
+	case region == "EU" || region == "EZ" || region == "UN" || region == "AA" || region == "ZZ":
+		return true
+	case region[0] == 'Q' && region[1] >= 'M', region[0] == 'X':
+		return true
+	}
+	return tables.countries2[region]
+}
+
+// parseLanguageTag reports whether str is a well-formed BCP 47 language tag as defined by RFC 5646, whose language,
+// script and region subtags are registered codes.
+func parseLanguageTag(str string) bool {
+	tag := strings.ToLower(str)
+	if grandfatheredLanguageTags[tag] {
+		return true
+	}
+
+	subtags := strings.Split(tag, "-")
+	for _, subtag := range subtags {
+		if len(subtag) == 0 || len(subtag) > 8 || !isAlphaNum(subtag) {
+			return false
+		}
+	}
+	if subtags[0] == "x" {
+		return len(subtags) > 1
+	}
+
+	tables := loadISOTables()
+	i := 0
+	next := func() string {
+		if i < len(subtags) {
+			return subtags[i]
+		}
+		return ""
+	}
+
+	// The language, only of 2 or 3 letters since the longer forms are reserved or unused, and up to three extlang.
+	language := next()
+	if len(language) < 2 || len(language) > 3 || !isAlpha(language) || !isLanguageSubtag(language, tables) {
+		return false
+	}
+	i++
+	for n := 0; n < 3 && len(next()) == 3 && isAlpha(next()); n++ {
+		if !isLanguageSubtag(next(), tables) {
+			return false
+		}
+		i++
+	}
+
+	if s := next(); len(s) == 4 && isAlpha(s) {
+		if !isScriptSubtag(s, tables) {
+			return false
+		}
+		i++
+	}
+
+	if s := next(); (len(s) == 2 && isAlpha(s)) || (len(s) == 3 && isDigits(s)) {
+		if !isRegionSubtag(s, tables) {
+			return false
+		}
+		i++
+	}
+
+	variants := map[string]bool{}
+	for s := next(); len(s) >= 5 || (len(s) == 4 && isDigits(s[:1])); s = next() {
+		if variants[s] {
+			return false
+		}
+		variants[s] = true
+		i++
+	}
+
+	singletons := map[string]bool{}
+	for s := next(); len(s) == 1 && s != "x"; s = next() {
+		if singletons[s] {
+			return false
+		}
+		singletons[s] = true
+		i++
+		n := 0
+		for ; len(next()) >= 2; n++ {
+			i++
+		}
+		if n == 0 {
+			return false
+		}
+	}
+
+	if next() == "x" {
+		return len(subtags) > i+1
+	}
+	return i == len(subtags)
+}
+
+// ValidateLanguage check if the string is a BCP 47 language tag, such as en, zh-Hant-HK or es-419, with registered
+// language, script and region subtags. Empty string is valid.
+func ValidateLanguage(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return parseLanguageTag(str)
+}
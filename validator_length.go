@@ -0,0 +1,354 @@
+package validator
+
+import (
+	_ "embed" // the Unicode properties are embedded from data
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:embed data/unicode.txt
+var unicodeData string
+
+// LengthMode is the unit in which the between, gt, gte, lt, lte, max, min and size rules measure strings.
+// It is selected per tag with a suffix, as in max=20:bytes, or for all tags with Validator.LengthMode.
+type LengthMode int
+
+const (
+	// LengthRunes counts Unicode code points. It is the default.
+	LengthRunes LengthMode = iota
+	// LengthBytes counts the bytes of the UTF-8 encoding, as limited by database columns.
+	LengthBytes
+	// LengthGraphemes counts the extended grapheme clusters of Unicode Standard Annex #29, the characters users see,
+	// so that an emoji ZWJ sequence, a flag or a letter with combining marks counts as one.
+	LengthGraphemes
+	// LengthWidth counts the columns of a monospace display: 2 for the wide and fullwidth characters of
+	// East Asian Width and emoji, 0 for control characters and 1 for other grapheme clusters.
+	LengthWidth
+)
+
+var lengthModeNames = [...]string{"runes", "bytes", "graphemes", "width"}
+
+// String returns the name of the mode used in tags.
+func (m LengthMode) String() string {
+	if m < 0 || int(m) >= len(lengthModeNames) {
+		return "LengthMode(" + strconv.Itoa(int(m)) + ")"
+	}
+	return lengthModeNames[m]
+}
+
+// StringLength returns the length of the string in the mode.
+func StringLength(str string, mode LengthMode) int {
+	switch mode {
+	case LengthBytes:
+		return len(str)
+	case LengthGraphemes:
+		n := 0
+		forEachGrapheme(str, func(string) { n++ })
+		return n
+	case LengthWidth:
+		return stringWidth(str)
+	default:
+		return utf8.RuneCountInString(str)
+	}
+}
+
+// lengthRules are the rules measuring strings in a LengthMode.
+var lengthRules = map[string]bool{
+	"between": true, "gt": true, "gte": true, "lt": true, "lte": true, "max": true, "min": true, "size": true,
+}
+
+// splitLengthMode splits the mode suffix from a param of a length rule, such as 20:bytes.
+func splitLengthMode(param string) (string, LengthMode, bool, error) {
+	value, name, ok := strings.Cut(param, ":")
+	if !ok {
+		return param, LengthRunes, false, nil
+	}
+	for mode, modeName := range lengthModeNames {
+		if name == modeName {
+			return value, LengthMode(mode), true, nil
+		}
+	}
+	return value, LengthRunes, false, fmt.Errorf("validator: unknown length mode %s", name)
+}
+
+// parseLengthParams parses the lengths of the params of a length rule and their mode. The mode suffix may be given
+// on any param, as in between=1|20:bytes, but not differently on two of them.
+func parseLengthParams(params ...string) ([]int64, LengthMode, error) {
+	lengths := make([]int64, len(params))
+	mode := LengthRunes
+	found := false
+	for i, param := range params {
+		value, m, ok, err := splitLengthMode(param)
+		if err != nil {
+			return nil, LengthRunes, err
+		}
+		if ok {
+			if found && m != mode {
+				return nil, LengthRunes, fmt.Errorf("validator: conflicting length modes %s and %s", mode, m)
+			}
+			mode, found = m, true
+		}
+		if lengths[i], err = ToInt(value); err != nil {
+			return nil, LengthRunes, err
+		}
+	}
+	return lengths, mode, nil
+}
+
+// hasLengthMode reports whether one of the params of a length rule has a mode suffix.
+func hasLengthMode(params []string) bool {
+	for _, param := range params {
+		if _, _, ok, _ := splitLengthMode(param); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withLengthMode returns the params of a length rule with the mode suffix appended to the last one.
+func withLengthMode(params []string, mode LengthMode) []string {
+	if len(params) == 0 {
+		return params
+	}
+	moded := append([]string(nil), params...)
+	moded[len(moded)-1] += ":" + mode.String()
+	return moded
+}
+
+// lengthMessageName returns the message of a length rule on a string measured in the mode. Bytes and columns
+// have their own messages, while graphemes are characters as much as runes are.
+func lengthMessageName(messageName string, mode LengthMode) string {
+	if !strings.HasSuffix(messageName, ".string") || (mode != LengthBytes && mode != LengthWidth) {
+		return messageName
+	}
+	return strings.TrimSuffix(messageName, "string") + mode.String()
+}
+
+// graphemeBreak is the Grapheme_Cluster_Break property of a rune.
+type graphemeBreak uint8
+
+const (
+	gbOther graphemeBreak = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+// conjunctBreak is the Indic_Conjunct_Break property of a rune.
+type conjunctBreak uint8
+
+const (
+	cbNone conjunctBreak = iota
+	cbLinker
+	cbConsonant
+	cbExtend
+)
+
+// unicodeRange is a range of runes sharing a property value.
+type unicodeRange struct {
+	lo, hi rune
+	value  uint8
+}
+
+// unicodeTables are the properties parsed from the embedded data on first use, each sorted by rune.
+type unicodeTables struct {
+	graphemeBreaks []unicodeRange
+	pictographic   []unicodeRange
+	conjunctBreaks []unicodeRange
+	wide           []unicodeRange
+}
+
+var (
+	unicodeTablesOnce sync.Once
+	unicodeTablesData *unicodeTables
+)
+
+var graphemeBreakNames = map[string]graphemeBreak{
+	"CR": gbCR, "LF": gbLF, "Control": gbControl, "Extend": gbExtend, "ZWJ": gbZWJ,
+	"Regional_Indicator": gbRegionalIndicator, "Prepend": gbPrepend, "SpacingMark": gbSpacingMark,
+	"L": gbL, "V": gbV, "T": gbT, "LV": gbLV, "LVT": gbLVT,
+}
+
+var conjunctBreakNames = map[string]conjunctBreak{
+	"InCB_Linker": cbLinker, "InCB_Consonant": cbConsonant, "InCB_Extend": cbExtend,
+}
+
+// loadUnicodeTables returns the Unicode properties, parsing them on first use. The data is generated, so a malformed
+// line is a bug of the package.
+func loadUnicodeTables() *unicodeTables {
+	unicodeTablesOnce.Do(func() {
+		t := &unicodeTables{}
+		for _, line := range strings.Split(unicodeData, "\n") {
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			runes, property, _ := strings.Cut(line, ";")
+			lo, hi, ok := strings.Cut(runes, "..")
+			if !ok {
+				hi = lo
+			}
+			first, err1 := strconv.ParseUint(lo, 16, 32)
+			last, err2 := strconv.ParseUint(hi, 16, 32)
+			if err1 != nil || err2 != nil {
+				panic("validator: invalid embedded table unicode: " + line)
+			}
+			r := unicodeRange{lo: rune(first), hi: rune(last)}
+			if gb, ok := graphemeBreakNames[property]; ok {
+				r.value = uint8(gb)
+				t.graphemeBreaks = append(t.graphemeBreaks, r)
+			} else if cb, ok := conjunctBreakNames[property]; ok {
+				r.value = uint8(cb)
+				t.conjunctBreaks = append(t.conjunctBreaks, r)
+			} else if property == "Extended_Pictographic" {
+				t.pictographic = append(t.pictographic, r)
+			} else if property == "Wide" {
+				t.wide = append(t.wide, r)
+			}
+		}
+		for _, ranges := range [][]unicodeRange{t.graphemeBreaks, t.pictographic, t.conjunctBreaks, t.wide} {
+			sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+		}
+		unicodeTablesData = t
+	})
+	return unicodeTablesData
+}
+
+// lookupRange returns the value of the range containing the rune, if any.
+func lookupRange(ranges []unicodeRange, r rune) (uint8, bool) {
+	i := sort.Search(len(ranges), func(i int) bool { return ranges[i].hi >= r })
+	if i < len(ranges) && ranges[i].lo <= r {
+		return ranges[i].value, true
+	}
+	return 0, false
+}
+
+// graphemeRune is a rune with the properties used to segment grapheme clusters.
+type graphemeRune struct {
+	gb           graphemeBreak
+	cb           conjunctBreak
+	pictographic bool
+}
+
+func (t *unicodeTables) properties(r rune) graphemeRune {
+	gb, _ := lookupRange(t.graphemeBreaks, r)
+	cb, _ := lookupRange(t.conjunctBreaks, r)
+	_, pictographic := lookupRange(t.pictographic, r)
+	return graphemeRune{gb: graphemeBreak(gb), cb: conjunctBreak(cb), pictographic: pictographic}
+}
+
+// forEachGrapheme calls fn with every extended grapheme cluster of the string, following the rules of
+// Unicode Standard Annex #29. Invalid UTF-8 is segmented as U+FFFD.
+func forEachGrapheme(str string, fn func(cluster string)) {
+	t := loadUnicodeTables()
+	var prev graphemeRune
+	start := 0
+	// regionalIndicators counts the regional indicators ending the cluster, emoji tracks an Extended_Pictographic
+	// followed by Extend* (1) and ZWJ (2), and conjunct a consonant followed by Extend or Linker (1) with a Linker (2).
+	regionalIndicators, emoji, conjunct := 0, 0, 0
+	for i, r := range str {
+		cur := t.properties(r)
+		if i > 0 && graphemeBoundary(prev, cur, regionalIndicators, emoji, conjunct) {
+			fn(str[start:i])
+			start = i
+		}
+
+		if cur.gb == gbRegionalIndicator {
+			regionalIndicators++
+		} else {
+			regionalIndicators = 0
+		}
+		switch {
+		case cur.pictographic:
+			emoji = 1
+		case emoji == 1 && cur.gb == gbExtend:
+		case emoji == 1 && cur.gb == gbZWJ:
+			emoji = 2
+		default:
+			emoji = 0
+		}
+		switch {
+		case cur.cb == cbConsonant:
+			conjunct = 1
+		case conjunct > 0 && cur.cb == cbLinker:
+			conjunct = 2
+		case conjunct > 0 && cur.cb == cbExtend:
+		default:
+			conjunct = 0
+		}
+		prev = cur
+	}
+	if start < len(str) {
+		fn(str[start:])
+	}
+}
+
+// graphemeBoundary reports whether there is a grapheme cluster boundary between the runes, given the state of the
+// runes before.
+func graphemeBoundary(prev, cur graphemeRune, regionalIndicators, emoji, conjunct int) bool {
+	switch {
+	case prev.gb == gbCR && cur.gb == gbLF: // GB3
+		return false
+	case prev.gb == gbCR || prev.gb == gbLF || prev.gb == gbControl: // GB4
+		return true
+	case cur.gb == gbCR || cur.gb == gbLF || cur.gb == gbControl: // GB5
+		return true
+	case prev.gb == gbL && (cur.gb == gbL || cur.gb == gbV || cur.gb == gbLV || cur.gb == gbLVT): // GB6
+		return false
+	case (prev.gb == gbLV || prev.gb == gbV) && (cur.gb == gbV || cur.gb == gbT): // GB7
+		return false
+	case (prev.gb == gbLVT || prev.gb == gbT) && cur.gb == gbT: // GB8
+		return false
+	case cur.gb == gbExtend || cur.gb == gbZWJ || cur.gb == gbSpacingMark || prev.gb == gbPrepend: // GB9, GB9a, GB9b
+		return false
+	case cur.cb == cbConsonant && conjunct == 2: // GB9c
+		return false
+	case cur.pictographic && emoji == 2: // GB11
+		return false
+	case cur.gb == gbRegionalIndicator && regionalIndicators%2 == 1: // GB12, GB13
+		return false
+	}
+	return true // GB999
+}
+
+// stringWidth returns the number of columns the string takes on a monospace display.
+func stringWidth(str string) int {
+	t := loadUnicodeTables()
+	width := 0
+	forEachGrapheme(str, func(cluster string) {
+		r, _ := utf8.DecodeRuneInString(cluster)
+		gb, _ := lookupRange(t.graphemeBreaks, r)
+		switch {
+		case graphemeBreak(gb) == gbControl || graphemeBreak(gb) == gbCR || graphemeBreak(gb) == gbLF:
+		case isWide(t, r) || (strings.ContainsRune(cluster, '\uFE0F') && isPictographic(t, r)):
+			// An emoji presentation selector makes text-style emoji wide.
+			width += 2
+		default:
+			width++
+		}
+	})
+	return width
+}
+
+func isWide(t *unicodeTables, r rune) bool {
+	_, ok := lookupRange(t.wide, r)
+	return ok
+}
+
+func isPictographic(t *unicodeTables, r rune) bool {
+	_, ok := lookupRange(t.pictographic, r)
+	return ok
+}
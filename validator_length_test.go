@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestStringLength(t *testing.T) {
+	var tests = []struct {
+		str       string
+		runes     int
+		bytes     int
+		graphemes int
+		width     int
+	}{
+		{"", 0, 0, 0, 0},
+		{"hello", 5, 5, 5, 5},
+		{"café", 4, 5, 4, 4},
+		{"cafe\u0301", 5, 6, 4, 4},
+		{"中文字", 3, 9, 3, 6},
+		{"한국어", 3, 9, 3, 6},
+		{"\u1100\u1161\u11a8", 3, 9, 1, 2},
+		{"\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466", 7, 25, 1, 2},
+		{"🇭🇰🇨🇳", 4, 16, 2, 4},
+		{"👍🏽", 2, 8, 1, 2},
+		{"\u2764\uFE0F", 2, 6, 1, 2},
+		{"\u0915\u094D\u0937\u093F", 4, 12, 1, 1},
+		{"a\r\nb", 4, 4, 3, 2},
+	}
+	for _, test := range tests {
+		for mode, expected := range map[LengthMode]int{
+			LengthRunes:     test.runes,
+			LengthBytes:     test.bytes,
+			LengthGraphemes: test.graphemes,
+			LengthWidth:     test.width,
+		} {
+			if actual := StringLength(test.str, mode); actual != expected {
+				t.Errorf("Expected StringLength(%q, %s) to be %d, got %d", test.str, mode, expected, actual)
+			}
+		}
+	}
+}
+
+func TestParseLengthParams(t *testing.T) {
+	lengths, mode, err := parseLengthParams("1", "20:bytes")
+	if err != nil || mode != LengthBytes || lengths[0] != 1 || lengths[1] != 20 {
+		t.Errorf("Unexpected result: %v %s %v", lengths, mode, err)
+	}
+	if _, _, err := parseLengthParams("1:width", "20:bytes"); err == nil {
+		t.Error("Expected an error for conflicting modes")
+	}
+	if _, _, err := parseLengthParams("20:words"); err == nil {
+		t.Error("Expected an error for an unknown mode")
+	}
+}
+
+func TestValidateLengthModes(t *testing.T) {
+	type Profile struct {
+		Nickname string `valid:"max=3:graphemes"`
+		Bio      string `valid:"max=8:bytes"`
+		Label    string `valid:"between=2|4:width"`
+		Title    string `valid:"lte=Label:width"`
+	}
+
+	var tests = []struct {
+		param    Profile
+		expected string
+	}{
+		{Profile{Nickname: "\U0001F468\u200D\U0001F469\u200D\U0001F467\u200D\U0001F466🇭🇰e\u0301", Bio: "café", Label: "中文", Title: "中文"}, ""},
+		{Profile{Nickname: "abcd"}, "The Nickname may not be greater than 3 characters."},
+		{Profile{Bio: "中文字"}, "The Bio may not be greater than 8 bytes."},
+		{Profile{Label: "中文字"}, "The Label must be between 2 and 4 columns wide."},
+		{Profile{Label: "中", Title: "中文"}, "The Title may not be wider than Label columns."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		actual := ""
+		if err != nil {
+			actual = err.(Errors)[0].Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%+v) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidatorLengthMode(t *testing.T) {
+	type Post struct {
+		Title string `valid:"max=4"`
+		Slug  string `valid:"max=4:runes"`
+	}
+
+	v := New()
+	v.LengthMode = LengthBytes
+	err := v.ValidateStruct(Post{Title: "中文", Slug: "中文"}, nil, nil)
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	errs := err.(Errors)
+	if len(errs) != 1 || errs[0].Error() != "The Title may not be greater than 4 bytes." {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+
+	if err := New().ValidateStruct(Post{Title: "中文", Slug: "中文"}, nil, nil); err != nil {
+		t.Errorf("Expected runes by default, got %v", err)
+	}
+}
@@ -0,0 +1,475 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+const modTagName string = "mod"
+
+// ModifierFunc modifies a settable field in place before it is validated. params are the values of the modifier in
+// the mod tag, as 20 in truncate=20.
+type ModifierFunc func(field reflect.Value, params []string) error
+
+// stringModifierFunc modifies a string.
+type stringModifierFunc func(str string, params []string) (string, error)
+
+// ModifierMap is a map of the built-in modifiers, that can be used in the mod tag. Modifiers registered on a
+// Validator with RegisterModifier take precedence.
+var ModifierMap = map[string]ModifierFunc{
+	"trim":         stringModifier("trim", modifyTrim),
+	"lower":        stringModifier("lower", modifyLower),
+	"upper":        stringModifier("upper", modifyUpper),
+	"title":        stringModifier("title", modifyTitle),
+	"squashSpaces": stringModifier("squashSpaces", modifySquashSpaces),
+	"nfc":          stringModifier("nfc", modifyNFC),
+	"nfkc":         stringModifier("nfkc", modifyNFKC),
+	"stripHtml":    stringModifier("stripHtml", modifyStripHTML),
+	"digitsOnly":   stringModifier("digitsOnly", modifyDigitsOnly),
+	"truncate":     stringModifier("truncate", modifyTruncate),
+}
+
+// stringModifier returns a ModifierFunc applying fn to string fields.
+func stringModifier(name string, fn stringModifierFunc) ModifierFunc {
+	return func(field reflect.Value, params []string) error {
+		if field.Kind() != reflect.String {
+			return fmt.Errorf("validator: %s modifier unsupported type %s", name, field.Type())
+		}
+		str, err := fn(field.String(), params)
+		if err != nil {
+			return err
+		}
+		field.SetString(str)
+		return nil
+	}
+}
+
+// RegisterModifier registers fn as the modifier name of the mod tag on the Validator.
+func (v *Validator) RegisterModifier(name string, fn ModifierFunc) {
+	if v.modifiers == nil {
+		v.modifiers = map[string]ModifierFunc{}
+	}
+	v.modifiers[name] = fn
+}
+
+// modifier is a modifier of a mod tag with its params.
+type modifier struct {
+	name   string
+	params []string
+}
+
+// modField is a struct field with modifiers, a default or nested fields that may have some.
+type modField struct {
+	index        int
+	modifiers    []modifier
+	defaultValue string
+	hasDefault   bool
+	nested       bool
+}
+
+var (
+	modCache sync.Map // map[reflect.Type][]modField
+	// modPending holds the types whose fields are being collected, so that recursive types are walked lazily.
+	modPending sync.Map // map[reflect.Type]bool
+)
+
+// parseModTag parses a mod tag such as "trim,lower,truncate=20".
+func parseModTag(tag string) []modifier {
+	var modifiers []modifier
+	for _, option := range strings.Split(tag, ",") {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		name, param, ok := strings.Cut(option, "=")
+		m := modifier{name: name}
+		if ok {
+			m.params = strings.Split(param, "|")
+		}
+		modifiers = append(modifiers, m)
+	}
+	return modifiers
+}
+
+// mayHaveModifiers reports whether values of the type may hold structs with modifiers or defaults.
+func mayHaveModifiers(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	if _, pending := modPending.Load(t); pending {
+		return true
+	}
+	return len(cachedModFields(t)) > 0
+}
+
+// cachedModFields returns the fields of the struct type with modifiers, defaults or nested fields that may have some.
+func cachedModFields(t reflect.Type) []modField {
+	if f, ok := modCache.Load(t); ok {
+		return f.([]modField)
+	}
+	modPending.Store(t, true)
+	var fields []modField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+		f := modField{index: i}
+		if sf.PkgPath == "" {
+			f.modifiers = parseModTag(sf.Tag.Get(modTagName))
+			f.defaultValue, f.hasDefault = sf.Tag.Lookup(defaultTagName)
+		}
+		f.nested = mayHaveModifiers(sf.Type)
+		if len(f.modifiers) > 0 || f.hasDefault || f.nested {
+			fields = append(fields, f)
+		}
+	}
+	modPending.Delete(t)
+	f, _ := modCache.LoadOrStore(t, fields)
+	return f.([]modField)
+}
+
+// Modify applies the modifiers of the mod tags of the struct s, which must be a pointer, and of its nested structs.
+func (v *Validator) Modify(s interface{}) error {
+	val := reflect.ValueOf(s)
+	if val.Kind() != reflect.Ptr || val.IsNil() || val.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("validator: Modify only accepts pointers to structs; got %T", s)
+	}
+	return v.prepareStruct(val.Elem(), true, false)
+}
+
+// prepared applies the modifiers and then the defaults to the struct s before it is validated. A struct passed by
+// value is prepared as a copy, so that its prepared values are validated while the struct of the caller is left as
+// it is.
+func (v *Validator) prepared(s interface{}) (interface{}, error) {
+	val := reflect.ValueOf(s)
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() || val.Elem().Kind() != reflect.Struct {
+			return s, nil
+		}
+		return s, v.prepareStruct(val.Elem(), true, true)
+	}
+	if val.Kind() != reflect.Struct || len(cachedModFields(val.Type())) == 0 {
+		return s, nil
+	}
+	p := reflect.New(val.Type())
+	p.Elem().Set(val)
+	return p.Interface(), v.prepareStruct(p.Elem(), true, true)
+}
+
+// prepareStruct applies the modifiers of the fields of an addressable struct when modify is set, and sets its zero
+// fields to their defaults when fill is set.
+func (v *Validator) prepareStruct(val reflect.Value, modify, fill bool) error {
+	fields := cachedModFields(val.Type())
+	for i := range fields {
+		f := &fields[i]
+		field := val.Field(f.index)
+		if modify && len(f.modifiers) > 0 && field.CanSet() {
+			if err := v.modifyValue(field, f.modifiers); err != nil {
+				return err
+			}
+		}
+		if fill && f.hasDefault && field.CanSet() && isZero(field) {
+			if err := v.setDefault(val, f, field); err != nil {
+				return err
+			}
+		}
+		if f.nested {
+			if err := v.prepareNested(field, modify, fill); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// modifyValue applies the modifiers to a field, following pointers. The modifiers of a slice or array of strings
+// apply to each string.
+func (v *Validator) modifyValue(field reflect.Value, modifiers []modifier) error {
+	switch field.Kind() {
+	case reflect.Ptr:
+		if field.IsNil() {
+			return nil
+		}
+		return v.modifyValue(field.Elem(), modifiers)
+	case reflect.Slice, reflect.Array:
+		elem := field.Type().Elem()
+		for elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.String {
+			for i := 0; i < field.Len(); i++ {
+				if err := v.modifyValue(field.Index(i), modifiers); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	for _, m := range modifiers {
+		fn, ok := v.modifiers[m.name]
+		if !ok {
+			fn, ok = ModifierMap[m.name]
+		}
+		if !ok {
+			return fmt.Errorf("validator: unknown modifier %s", m.name)
+		}
+		if err := fn(field, m.params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareNested prepares the structs held by a field, directly, through pointers or as the elements of slices,
+// arrays and maps. Structs held by value in maps are not addressable and are left as they are.
+func (v *Validator) prepareNested(field reflect.Value, modify, fill bool) error {
+	switch field.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if field.IsNil() {
+			return nil
+		}
+		return v.prepareNested(field.Elem(), modify, fill)
+	case reflect.Struct:
+		if !field.CanAddr() {
+			return nil
+		}
+		return v.prepareStruct(field, modify, fill)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < field.Len(); i++ {
+			if err := v.prepareNested(field.Index(i), modify, fill); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := field.MapRange()
+		for iter.Next() {
+			if err := v.prepareNested(iter.Value(), modify, fill); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func modifyTrim(str string, _ []string) (string, error) {
+	return strings.TrimSpace(str), nil
+}
+
+func modifyLower(str string, _ []string) (string, error) {
+	return strings.ToLower(str), nil
+}
+
+func modifyUpper(str string, _ []string) (string, error) {
+	return strings.ToUpper(str), nil
+}
+
+// modifyTitle upper-cases the first letter of each word and lower-cases the others. Apostrophes do not start a
+// word, so that "o'neil" becomes "O'neil".
+func modifyTitle(str string, _ []string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(str))
+	inWord := false
+	for _, r := range str {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r):
+			if inWord {
+				b.WriteRune(unicode.ToLower(r))
+			} else {
+				b.WriteRune(unicode.ToTitle(r))
+			}
+			inWord = true
+		case r == '\'' || r == '\u2019':
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+			inWord = false
+		}
+	}
+	return b.String(), nil
+}
+
+// modifySquashSpaces replaces each run of white space with a single space.
+func modifySquashSpaces(str string, _ []string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(str))
+	space := false
+	for _, r := range str {
+		if unicode.IsSpace(r) {
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		b.WriteRune(r)
+	}
+	return b.String(), nil
+}
+
+func modifyNFC(str string, _ []string) (string, error) {
+	return normalizeString(str, false), nil
+}
+
+func modifyNFKC(str string, _ []string) (string, error) {
+	return normalizeString(str, true), nil
+}
+
+// modifyStripHTML removes the tags and comments of HTML and the content of script and style elements. Character
+// references are kept as they are, so that escaped markup does not become markup.
+func modifyStripHTML(str string, _ []string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(str))
+	for len(str) > 0 {
+		i := strings.IndexByte(str, '<')
+		if i < 0 {
+			b.WriteString(str)
+			break
+		}
+		b.WriteString(str[:i])
+		str = str[i:]
+
+		if strings.HasPrefix(str, "<!--") {
+			if end := strings.Index(str[4:], "-->"); end >= 0 {
+				str = str[4+end+3:]
+			} else {
+				str = ""
+			}
+			continue
+		}
+		if len(str) < 2 || !(isASCIILetter(str[1]) || str[1] == '/' || str[1] == '!' || str[1] == '?') {
+			// A < that does not open a tag is text.
+			b.WriteByte('<')
+			str = str[1:]
+			continue
+		}
+		end := tagEnd(str)
+		if end < 0 {
+			break
+		}
+		tag := str[:end]
+		str = str[end:]
+		for _, raw := range []string{"script", "style"} {
+			if htmlTagName(tag) == raw {
+				if closing := indexFold(str, "</"+raw); closing >= 0 {
+					str = str[closing:]
+				} else {
+					str = ""
+				}
+			}
+		}
+	}
+	return b.String(), nil
+}
+
+func isASCIILetter(c byte) bool {
+	return ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// tagEnd returns the index after the > closing the tag at the start of str, skipping quoted attribute values, or -1.
+func tagEnd(str string) int {
+	var quote byte
+	for i := 1; i < len(str); i++ {
+		switch c := str[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// htmlTagName returns the lower-cased name of an opening tag, or "" for other tags.
+func htmlTagName(tag string) string {
+	i := 1
+	for i < len(tag) && (isASCIILetter(tag[i]) || ('0' <= tag[i] && tag[i] <= '9')) {
+		i++
+	}
+	return strings.ToLower(tag[1:i])
+}
+
+// indexFold returns the index of the first ASCII case-insensitive instance of substr in s, or -1.
+func indexFold(s, substr string) int {
+	for i := 0; i+len(substr) <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len(substr)], substr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// modifyDigitsOnly removes all but the ASCII digits, as from phone or card numbers.
+func modifyDigitsOnly(str string, _ []string) (string, error) {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, str), nil
+}
+
+// modifyTruncate shortens the string to at most the length of its param, counted in runes unless the param selects
+// a LengthMode, as in truncate=255:bytes. Strings are only cut between grapheme clusters in the graphemes and width
+// modes, and between runes in the bytes mode.
+func modifyTruncate(str string, params []string) (string, error) {
+	if len(params) != 1 {
+		return "", fmt.Errorf("validator: truncate modifier params length must be 1")
+	}
+	lengths, mode, err := parseLengthParams(params...)
+	if err != nil {
+		return "", fmt.Errorf("validator: invalid parameter for truncate modifier: %w", err)
+	}
+	limit := int(lengths[0])
+	if limit < 0 {
+		return "", fmt.Errorf("validator: truncate modifier length must not be negative")
+	}
+
+	switch mode {
+	case LengthBytes:
+		if len(str) <= limit {
+			return str, nil
+		}
+		for limit > 0 && !utf8.RuneStart(str[limit]) {
+			limit--
+		}
+		return str[:limit], nil
+	case LengthGraphemes, LengthWidth:
+		end, length := 0, 0
+		done := false
+		forEachGrapheme(str, func(cluster string) {
+			if done {
+				return
+			}
+			length += StringLength(cluster, mode)
+			if length > limit {
+				done = true
+				return
+			}
+			end += len(cluster)
+		})
+		return str[:end], nil
+	default:
+		n := 0
+		for i := range str {
+			if n == limit {
+				return str[:i], nil
+			}
+			n++
+		}
+		return str, nil
+	}
+}
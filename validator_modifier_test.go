@@ -0,0 +1,160 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestModifiers(t *testing.T) {
+	var tests = []struct {
+		modifier string
+		params   []string
+		str      string
+		expected string
+	}{
+		{"trim", nil, " \t hello \n", "hello"},
+		{"lower", nil, "HeLLo \u00c9T\u00c9", "hello \u00e9t\u00e9"},
+		{"upper", nil, "\u00e9t\u00e9 \u03c3", "\u00c9T\u00c9 \u03a3"},
+		{"title", nil, "o'neil mcDONALD-smith jr.", "O'neil Mcdonald-Smith Jr."},
+		{"squashSpaces", nil, "a  b\t\n c ", "a b c "},
+		{"nfc", nil, "e\u0301", "\u00e9"},
+		{"nfc", nil, "d\u0307\u0323", "\u1e0d\u0307"},
+		{"nfc", nil, "\u1100\u1161\u11a8", "\uac01"},
+		{"nfc", nil, "\ufb01", "\ufb01"},
+		{"nfkc", nil, "\ufb01 \u2460 \uff21", "fi 1 A"},
+		{"nfkc", nil, "\u1e9b\u0323", "\u1e69"},
+		{"stripHtml", nil, `<p class="a>b">Hello <b>world</b></p><!-- note -->`, "Hello world"},
+		{"stripHtml", nil, "<script>alert(1)</script>a < b &amp; c<STYLE>p{}</style>", "a < b &amp; c"},
+		{"digitsOnly", nil, "+852 (2) 123-4567", "85221234567"},
+		{"truncate", []string{"5"}, "h\u00e9llo world", "h\u00e9llo"},
+		{"truncate", []string{"3:bytes"}, "h\u00e9llo", "h\u00e9"},
+		{"truncate", []string{"2:bytes"}, "h\u00e9llo", "h"},
+		{"truncate", []string{"2:graphemes"}, "e\u0301e\u0301e\u0301", "e\u0301e\u0301"},
+		{"truncate", []string{"3:width"}, "\u4e2d\u6587\u5b57", "\u4e2d"},
+		{"truncate", []string{"10"}, "short", "short"},
+	}
+	for _, test := range tests {
+		value := reflect.New(reflect.TypeOf("")).Elem()
+		value.SetString(test.str)
+		if err := ModifierMap[test.modifier](value, test.params); err != nil {
+			t.Errorf("Unexpected error of %s %v on %q: %v", test.modifier, test.params, test.str, err)
+		}
+		if actual := value.String(); actual != test.expected {
+			t.Errorf("Expected %s %v of %q to be %q, got %q", test.modifier, test.params, test.str, test.expected, actual)
+		}
+	}
+}
+
+type modAddress struct {
+	City string `mod:"trim,title" valid:"required"`
+}
+
+type modUser struct {
+	Name      string     `mod:"trim,squashSpaces" valid:"required,max=10"`
+	Email     *string    `mod:"trim,lower" valid:"email"`
+	Phone     string     `mod:"digitsOnly" valid:"size=8"`
+	Tags      []string   `mod:"trim,upper"`
+	Address   modAddress `valid:"required"`
+	Addresses []*modAddress
+	Friends   []modUser
+	Parent    *modUser
+	internal  string
+}
+
+func TestValidateStructModifiers(t *testing.T) {
+	email := " Alice@Example.COM "
+	user := &modUser{
+		Name:      "  Alice   Chan ",
+		Email:     &email,
+		Phone:     "9123-4567",
+		Tags:      []string{" a ", "b"},
+		Address:   modAddress{City: " hong kong"},
+		Addresses: []*modAddress{{City: "kowloon "}},
+		Friends:   []modUser{{Name: " Bob ", Phone: "2345 6789", Address: modAddress{City: "macau"}}},
+		Parent:    &modUser{Name: " Carol", Phone: "34567890", Address: modAddress{City: "taipei"}},
+		internal:  " kept ",
+	}
+	if err := ValidateStruct(user); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := &modUser{
+		Name:      "Alice Chan",
+		Email:     user.Email,
+		Phone:     "91234567",
+		Tags:      []string{"A", "B"},
+		Address:   modAddress{City: "Hong Kong"},
+		Addresses: []*modAddress{{City: "Kowloon"}},
+		Friends:   []modUser{{Name: "Bob", Phone: "23456789", Address: modAddress{City: "Macau"}}},
+		Parent:    &modUser{Name: "Carol", Phone: "34567890", Address: modAddress{City: "Taipei"}},
+		internal:  " kept ",
+	}
+	if *user.Email != "alice@example.com" || !reflect.DeepEqual(user, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, user)
+	}
+}
+
+func TestValidateStructModifiersByValue(t *testing.T) {
+	user := modUser{Name: " Alice ", Phone: "9123 4567", Address: modAddress{City: "macau"}}
+	if err := ValidateStruct(user); err != nil {
+		t.Errorf("Expected the modified copy to be valid, got %v", err)
+	}
+	if user.Name != " Alice " {
+		t.Errorf("Expected a struct passed by value to be left as it is, got %q", user.Name)
+	}
+
+	user.Name = "  Alexander  Chan  "
+	err := ValidateStruct(&user)
+	if err == nil || err.(Errors)[0].Error() != "The Name may not be greater than 10 characters." {
+		t.Errorf("Expected the modified value to be validated, got %v", err)
+	}
+	if user.Name != "Alexander Chan" {
+		t.Errorf("Expected the Name to be modified, got %q", user.Name)
+	}
+}
+
+func TestRegisterModifier(t *testing.T) {
+	type Post struct {
+		Slug  string `mod:"trim,slug"`
+		Score int    `mod:"clamp=0|10"`
+	}
+
+	v := New()
+	v.RegisterModifier("slug", func(field reflect.Value, params []string) error {
+		field.SetString(strings.ReplaceAll(strings.ToLower(field.String()), " ", "-"))
+		return nil
+	})
+	v.RegisterModifier("clamp", func(field reflect.Value, params []string) error {
+		lo, _ := ToInt(params[0])
+		hi, _ := ToInt(params[1])
+		if n := field.Int(); n < lo {
+			field.SetInt(lo)
+		} else if n > hi {
+			field.SetInt(hi)
+		}
+		return nil
+	})
+
+	post := &Post{Slug: " Hello World ", Score: 42}
+	if err := v.Modify(post); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if post.Slug != "hello-world" || post.Score != 10 {
+		t.Errorf("Unexpected modified post %+v", post)
+	}
+
+	if err := ValidateStruct(&Post{}); err == nil || err.Error() != "validator: unknown modifier slug" {
+		t.Errorf("Expected the modifier not to be registered on other Validators, got %v", err)
+	}
+	if err := v.Modify(Post{}); err == nil {
+		t.Error("Expected an error for a struct passed by value")
+	}
+
+	type Count struct {
+		N int `mod:"trim"`
+	}
+	if err := ValidateStruct(&Count{}); err == nil {
+		t.Error("Expected an error for a string modifier on an int")
+	}
+}
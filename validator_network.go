@@ -0,0 +1,295 @@
+package validator
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxUnixAddrLength is the size of sun_path in a Linux sockaddr_un, the longest unix socket path accepted.
+const maxUnixAddrLength = 108
+
+// nonPublicPrefixes are the special-purpose ranges of the IANA registries that are not globally reachable,
+// beyond the private, loopback, link-local and multicast ranges netip already classifies.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("192.88.99.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001::/23"),
+	netip.MustParsePrefix("2001:db8::/32"),
+	netip.MustParsePrefix("3fff::/20"),
+}
+
+// parseAddr parses an IP address without a zone, unmapping IPv4-mapped IPv6 addresses.
+func parseAddr(str string) (netip.Addr, bool) {
+	addr, err := netip.ParseAddr(str)
+	if err != nil || addr.Zone() != "" {
+		return netip.Addr{}, false
+	}
+	return addr.Unmap(), true
+}
+
+// ValidateCIDR check if the string is an IP address and prefix length in CIDR notation, such as 10.0.0.0/8. Empty string is valid.
+func ValidateCIDR(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	_, err := netip.ParsePrefix(str)
+	return err == nil
+}
+
+// ValidateCIDRv4 check if the string is an IPv4 address and prefix length in CIDR notation. Empty string is valid.
+func ValidateCIDRv4(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	prefix, err := netip.ParsePrefix(str)
+	return err == nil && prefix.Addr().Is4()
+}
+
+// ValidateCIDRv6 check if the string is an IPv6 address and prefix length in CIDR notation. Empty string is valid.
+func ValidateCIDRv6(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	prefix, err := netip.ParsePrefix(str)
+	return err == nil && prefix.Addr().Is6()
+}
+
+// ValidateMAC check if the string is an EUI-48 or EUI-64 MAC address, such as 00:00:5e:00:53:01. Empty string is valid.
+func ValidateMAC(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	mac, err := net.ParseMAC(str)
+	return err == nil && (len(mac) == 6 || len(mac) == 8)
+}
+
+// isHostname reports whether str is a hostname as defined by RFC 1123, without a trailing dot.
+func isHostname(str string) bool {
+	if len(str) == 0 || len(str) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(str, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for i := 0; i < len(label); i++ {
+			c := label[i]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '-') {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ValidateHostname check if the string is a hostname as defined by RFC 1123. Empty string is valid.
+func ValidateHostname(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return isHostname(str)
+}
+
+// ValidateFQDN check if the string is a fully qualified domain name, with at least two labels and an alphabetic top-level domain.
+// A trailing dot is allowed. Empty string is valid.
+func ValidateFQDN(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	str = strings.TrimSuffix(str, ".")
+	i := strings.LastIndexByte(str, '.')
+	if i < 0 || !isHostname(str) {
+		return false
+	}
+	_, err := strconv.Atoi(str[i+1:])
+	return err != nil
+}
+
+// isPort reports whether str is a decimal port number between 1 and 65535.
+func isPort(str string) bool {
+	port, err := strconv.ParseUint(str, 10, 16)
+	return err == nil && port > 0
+}
+
+// ValidatePort check if the string is a port number between 1 and 65535. Empty string is valid.
+func ValidatePort(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return isPort(str)
+}
+
+// validatePort is the validation function for validating the string or integer is a port number between 1 and 65535.
+func validatePort(v reflect.Value) (bool, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return ValidatePort(v.String()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() > 0 && v.Int() <= 65535, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() > 0 && v.Uint() <= 65535, nil
+	default:
+		return false, fmt.Errorf("validator: Port unsupported type %s", v.Type())
+	}
+}
+
+// ValidateHostPort check if the string is a host and port, such as example.com:443, 10.0.0.1:80 or [::1]:8080.
+// The host must be a hostname or an IP address, IPv6 addresses in brackets. Empty string is valid.
+func ValidateHostPort(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	host, port, err := net.SplitHostPort(str)
+	if err != nil || !isPort(port) {
+		return false
+	}
+	if _, ok := parseAddr(host); ok {
+		return true
+	}
+	return isHostname(host)
+}
+
+// ValidateUnixAddr check if the string is a unix domain socket path, or a Linux abstract socket name starting with "@".
+// Empty string is valid.
+func ValidateUnixAddr(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return len(str) <= maxUnixAddrLength && !strings.ContainsRune(str, 0)
+}
+
+// ValidatePublicIP check if the string is a globally reachable unicast IP address. Private, loopback, link-local,
+// shared, documentation and other special-purpose addresses are not public. Empty string is valid.
+func ValidatePublicIP(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	addr, ok := parseAddr(str)
+	return ok && isPublicAddr(addr)
+}
+
+// isPublicAddr reports whether addr is a globally reachable unicast address.
+func isPublicAddr(addr netip.Addr) bool {
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatePrivateIP check if the string is a private IP address, in 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16 or fc00::/7.
+// Empty string is valid.
+func ValidatePrivateIP(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	addr, ok := parseAddr(str)
+	return ok && addr.IsPrivate()
+}
+
+// ValidateLoopback check if the string is a loopback IP address, in 127.0.0.0/8 or ::1. Empty string is valid.
+func ValidateLoopback(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	addr, ok := parseAddr(str)
+	return ok && addr.IsLoopback()
+}
+
+// parsePrefixes parses the params of the ipIn and ipNotIn rules, each a CIDR or a single IP address.
+func parsePrefixes(rule string, params []string) ([]netip.Prefix, error) {
+	if len(params) == 0 {
+		return nil, fmt.Errorf("validator: %s params length must be at least 1", rule)
+	}
+	prefixes := make([]netip.Prefix, 0, len(params))
+	for _, param := range params {
+		if addr, ok := parseAddr(param); ok {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(param)
+		if err != nil {
+			return nil, fmt.Errorf("validator: %s invalid network %s", rule, param)
+		}
+		if prefix.Addr().Is4In6() && prefix.Bits() >= 96 {
+			prefix = netip.PrefixFrom(prefix.Addr().Unmap(), prefix.Bits()-96)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// ipInPrefixes reports whether str is an IP address, and whether it is in one of the prefixes.
+func ipInPrefixes(str string, prefixes []netip.Prefix) (valid bool, in bool) {
+	addr, ok := parseAddr(str)
+	if !ok {
+		return false, false
+	}
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true, true
+		}
+	}
+	return true, false
+}
+
+// ValidateIPIn check if the string is an IP address in one of the networks, each a CIDR or a single IP address.
+// Empty string is valid.
+func ValidateIPIn(str string, networks []string) (bool, error) {
+	prefixes, err := parsePrefixes("IPIn", networks)
+	if err != nil {
+		return false, err
+	}
+	if IsNull(str) {
+		return true, nil
+	}
+	_, in := ipInPrefixes(str, prefixes)
+	return in, nil
+}
+
+// ValidateIPNotIn check if the string is an IP address in none of the networks, each a CIDR or a single IP address.
+// Empty string is valid.
+func ValidateIPNotIn(str string, networks []string) (bool, error) {
+	prefixes, err := parsePrefixes("IPNotIn", networks)
+	if err != nil {
+		return false, err
+	}
+	if IsNull(str) {
+		return true, nil
+	}
+	valid, in := ipInPrefixes(str, prefixes)
+	return valid && !in, nil
+}
+
+// validateIPIn is the validation function for validating the string is an IP address in one of the networks of params.
+func validateIPIn(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: IPIn unsupported type %s", v.Type())
+	}
+	return ValidateIPIn(v.String(), params)
+}
+
+// validateIPNotIn is the validation function for validating the string is an IP address in none of the networks of params.
+func validateIPNotIn(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: IPNotIn unsupported type %s", v.Type())
+	}
+	return ValidateIPNotIn(v.String(), params)
+}
@@ -0,0 +1,153 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateNetworkStrings(t *testing.T) {
+	var tests = []struct {
+		param    string
+		validate func(string) bool
+		expected bool
+	}{
+		{"", ValidateCIDR, true},
+		{"10.0.0.0/8", ValidateCIDR, true},
+		{"192.168.1.7/24", ValidateCIDR, true},
+		{"2001:db8::/32", ValidateCIDR, true},
+		{"10.0.0.0", ValidateCIDR, false},
+		{"10.0.0.0/33", ValidateCIDR, false},
+		{"10.0.0.0/8", ValidateCIDRv4, true},
+		{"2001:db8::/32", ValidateCIDRv4, false},
+		{"2001:db8::/32", ValidateCIDRv6, true},
+		{"10.0.0.0/8", ValidateCIDRv6, false},
+		{"00:00:5e:00:53:01", ValidateMAC, true},
+		{"00-00-5E-00-53-01", ValidateMAC, true},
+		{"0000.5e00.5301", ValidateMAC, true},
+		{"02:00:5e:10:00:00:00:01", ValidateMAC, true},
+		{"00:00:00:00:fe:80:00:00:00:00:00:00:02:00:5e:10:00:00:00:01", ValidateMAC, false},
+		{"00:00:5e:00:53", ValidateMAC, false},
+		{"localhost", ValidateHostname, true},
+		{"api-1.example.com", ValidateHostname, true},
+		{"3com.com", ValidateHostname, true},
+		{"-api.example.com", ValidateHostname, false},
+		{"api_1.example.com", ValidateHostname, false},
+		{"example.com.", ValidateHostname, false},
+		{strings.Repeat("a", 64) + ".com", ValidateHostname, false},
+		{"example.com", ValidateFQDN, true},
+		{"example.com.", ValidateFQDN, true},
+		{"localhost", ValidateFQDN, false},
+		{"10.0.0.1", ValidateFQDN, false},
+		{"443", ValidatePort, true},
+		{"65535", ValidatePort, true},
+		{"0", ValidatePort, false},
+		{"65536", ValidatePort, false},
+		{"+80", ValidatePort, false},
+		{"example.com:443", ValidateHostPort, true},
+		{"10.0.0.1:80", ValidateHostPort, true},
+		{"[::1]:8080", ValidateHostPort, true},
+		{"::1:8080", ValidateHostPort, false},
+		{"example.com", ValidateHostPort, false},
+		{"example.com:0", ValidateHostPort, false},
+		{":8080", ValidateHostPort, false},
+		{"/var/run/app.sock", ValidateUnixAddr, true},
+		{"@app", ValidateUnixAddr, true},
+		{"/tmp/" + strings.Repeat("a", 104), ValidateUnixAddr, false},
+		{"/tmp/a\x00b", ValidateUnixAddr, false},
+		{"8.8.8.8", ValidatePublicIP, true},
+		{"2606:4700:4700::1111", ValidatePublicIP, true},
+		{"::ffff:8.8.8.8", ValidatePublicIP, true},
+		{"10.1.2.3", ValidatePublicIP, false},
+		{"100.64.0.1", ValidatePublicIP, false},
+		{"192.0.2.1", ValidatePublicIP, false},
+		{"169.254.0.1", ValidatePublicIP, false},
+		{"224.0.0.1", ValidatePublicIP, false},
+		{"2001:db8::1", ValidatePublicIP, false},
+		{"fe80::1%eth0", ValidatePublicIP, false},
+		{"example.com", ValidatePublicIP, false},
+		{"10.1.2.3", ValidatePrivateIP, true},
+		{"172.31.0.1", ValidatePrivateIP, true},
+		{"fd00::1", ValidatePrivateIP, true},
+		{"172.32.0.1", ValidatePrivateIP, false},
+		{"127.0.0.1", ValidateLoopback, true},
+		{"127.8.8.8", ValidateLoopback, true},
+		{"::1", ValidateLoopback, true},
+		{"::ffff:127.0.0.1", ValidateLoopback, true},
+		{"10.0.0.1", ValidateLoopback, false},
+	}
+	for _, test := range tests {
+		if actual := test.validate(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateIPIn(t *testing.T) {
+	networks := []string{"10.0.0.0/8", "192.168.0.0/16", "2001:db8::/32", "203.0.113.7"}
+	var tests = []struct {
+		param string
+		in    bool
+		notIn bool
+	}{
+		{"", true, true},
+		{"10.20.30.40", true, false},
+		{"192.168.255.1", true, false},
+		{"::ffff:10.0.0.1", true, false},
+		{"2001:db8::5", true, false},
+		{"203.0.113.7", true, false},
+		{"203.0.113.8", false, true},
+		{"172.16.0.1", false, true},
+		{"not-an-ip", false, false},
+	}
+	for _, test := range tests {
+		if actual, err := ValidateIPIn(test.param, networks); err != nil || actual != test.in {
+			t.Errorf("Expected ValidateIPIn(%q) to be %v, got %v (%v)", test.param, test.in, actual, err)
+		}
+		if actual, err := ValidateIPNotIn(test.param, networks); err != nil || actual != test.notIn {
+			t.Errorf("Expected ValidateIPNotIn(%q) to be %v, got %v (%v)", test.param, test.notIn, actual, err)
+		}
+	}
+
+	if valid, _ := ValidateIPIn("10.1.2.3", []string{"::ffff:10.0.0.0/104"}); !valid {
+		t.Error("Expected IPv4-mapped network to contain the IPv4 address")
+	}
+	for _, networks := range [][]string{{}, {"10.0.0.0/40"}, {"example.com"}} {
+		if _, err := ValidateIPIn("10.0.0.1", networks); err == nil {
+			t.Errorf("Expected error for networks %v", networks)
+		}
+	}
+}
+
+func TestNetworkRules(t *testing.T) {
+	type Server struct {
+		Subnet  string `valid:"cidrv4"`
+		Host    string `valid:"fqdn"`
+		Port    int    `valid:"port"`
+		Address string `valid:"ipIn=10.0.0.0/8|192.168.0.0/16"`
+		Backend string `valid:"ipNotIn=127.0.0.0/8"`
+	}
+
+	server := Server{Subnet: "10.0.0.0/24", Host: "db.example.com", Port: 5432, Address: "10.0.0.5", Backend: "10.0.0.6"}
+	if err := ValidateStruct(&server); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	invalid := server
+	invalid.Port = 70000
+	err := ValidateStruct(&invalid)
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The Port must be a valid port number." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	invalid = server
+	invalid.Address = "172.16.0.1"
+	err = ValidateStruct(&invalid)
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The Address must be an IP address in: 10.0.0.0/8, 192.168.0.0/16." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if valid, err := validatePort(reflect.ValueOf(3.5)); valid || err == nil {
+		t.Error("Expected port rule to reject a float")
+	}
+}
@@ -0,0 +1,200 @@
+package validator
+
+import (
+	_ "embed" // the normalization tables are embedded from data
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+//go:embed data/normalization.txt
+var normalizationData string
+
+// normalizationTables are the decompositions and compositions of Unicode Standard Annex #15, parsed from the
+// embedded data on first use.
+type normalizationTables struct {
+	ccc        map[rune]uint8
+	nfd        map[rune][]rune
+	nfkd       map[rune][]rune
+	composites map[[2]rune]rune
+}
+
+var (
+	normalizationTablesOnce sync.Once
+	normalizationTablesData *normalizationTables
+)
+
+// Hangul syllables are decomposed and composed arithmetically, as described in chapter 3.12 of the Unicode Standard.
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// parseRunes parses the space-separated hexadecimal code points of the normalization data.
+func parseRunes(s string) ([]rune, bool) {
+	fields := strings.Fields(s)
+	runes := make([]rune, len(fields))
+	for i, f := range fields {
+		r, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			return nil, false
+		}
+		runes[i] = rune(r)
+	}
+	return runes, len(runes) > 0
+}
+
+// loadNormalizationTables returns the normalization tables, parsing them on first use. The data is generated, so a
+// malformed line is a bug of the package.
+func loadNormalizationTables() *normalizationTables {
+	normalizationTablesOnce.Do(func() {
+		t := &normalizationTables{
+			ccc:        map[rune]uint8{},
+			nfd:        map[rune][]rune{},
+			nfkd:       map[rune][]rune{},
+			composites: map[[2]rune]rune{},
+		}
+		for _, line := range strings.Split(normalizationData, "\n") {
+			if line == "" || line[0] == '#' {
+				continue
+			}
+			fields := strings.SplitN(line, ";", 3)
+			if len(fields) != 3 {
+				panic("validator: invalid embedded table normalization: " + line)
+			}
+			r, ok := parseRunes(fields[0])
+			if !ok {
+				panic("validator: invalid embedded table normalization: " + line)
+			}
+			if fields[1] == "ccc" {
+				class, err := strconv.ParseUint(fields[2], 10, 8)
+				if err != nil {
+					panic("validator: invalid embedded table normalization: " + line)
+				}
+				t.ccc[r[0]] = uint8(class)
+				continue
+			}
+			mapping, ok := parseRunes(fields[2])
+			if !ok {
+				panic("validator: invalid embedded table normalization: " + line)
+			}
+			switch fields[1] {
+			case "nfd":
+				t.nfd[r[0]] = mapping
+			case "nfkd":
+				t.nfkd[r[0]] = mapping
+			case "compose":
+				if len(mapping) != 2 {
+					panic("validator: invalid embedded table normalization: " + line)
+				}
+				t.composites[[2]rune{mapping[0], mapping[1]}] = r[0]
+			}
+		}
+		normalizationTablesData = t
+	})
+	return normalizationTablesData
+}
+
+// decompose returns the canonical or, with compat, the compatibility decomposition of the string in canonical order.
+func (t *normalizationTables) decompose(str string, compat bool) []rune {
+	runes := make([]rune, 0, len(str))
+	for _, r := range str {
+		if s := r - hangulSBase; s >= 0 && s < hangulSCount {
+			runes = append(runes, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+			if s%hangulTCount != 0 {
+				runes = append(runes, hangulTBase+s%hangulTCount)
+			}
+			continue
+		}
+		if mapping, ok := t.nfkd[r]; ok && compat {
+			runes = append(runes, mapping...)
+		} else if mapping, ok := t.nfd[r]; ok {
+			runes = append(runes, mapping...)
+		} else {
+			runes = append(runes, r)
+		}
+	}
+
+	// Sort each run of combining marks by their combining class, keeping the order of equal classes.
+	for i := 1; i < len(runes); i++ {
+		class := t.ccc[runes[i]]
+		if class == 0 {
+			continue
+		}
+		for j := i; j > 0; j-- {
+			prev := t.ccc[runes[j-1]]
+			if prev == 0 || prev <= class {
+				break
+			}
+			runes[j-1], runes[j] = runes[j], runes[j-1]
+		}
+	}
+	return runes
+}
+
+// composePair returns the primary composite of a starter and the following character, if any.
+func (t *normalizationTables) composePair(starter, r rune) (rune, bool) {
+	if l, v := starter-hangulLBase, r-hangulVBase; l >= 0 && l < hangulLCount && v >= 0 && v < hangulVCount {
+		return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+	}
+	if s, tr := starter-hangulSBase, r-hangulTBase; s >= 0 && s < hangulSCount && s%hangulTCount == 0 && tr > 0 && tr < hangulTCount {
+		return starter + tr, true
+	}
+	c, ok := t.composites[[2]rune{starter, r}]
+	return c, ok
+}
+
+// compose applies the canonical composition algorithm to decomposed runes.
+func (t *normalizationTables) compose(runes []rune) []rune {
+	if len(runes) == 0 {
+		return runes
+	}
+	starter := 0
+	// A leading combining mark has no starter to compose with.
+	lastClass := 256
+	if t.ccc[runes[0]] == 0 {
+		lastClass = 0
+	}
+	n := 1
+	for _, r := range runes[1:] {
+		class := int(t.ccc[r])
+		if lastClass < class || lastClass == 0 {
+			if c, ok := t.composePair(runes[starter], r); ok {
+				runes[starter] = c
+				continue
+			}
+		}
+		if class == 0 {
+			starter = n
+		}
+		lastClass = class
+		runes[n] = r
+		n++
+	}
+	return runes[:n]
+}
+
+// normalizeString returns the string in Normalization Form C or, with compat, KC. ASCII strings are returned as
+// they are.
+func normalizeString(str string, compat bool) string {
+	ascii := true
+	for i := 0; i < len(str); i++ {
+		if str[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return str
+	}
+	t := loadNormalizationTables()
+	return string(t.compose(t.decompose(str, compat)))
+}
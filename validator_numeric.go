@@ -0,0 +1,138 @@
+package validator
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+// isIntKind reports whether the kind is a signed integer.
+func isIntKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return true
+	}
+	return false
+}
+
+// isUintKind reports whether the kind is an unsigned integer.
+func isUintKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	}
+	return false
+}
+
+// isFloatKind reports whether the kind is a floating-point number.
+func isFloatKind(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// isNumberKind reports whether the kind is a signed or unsigned integer or a floating-point number.
+func isNumberKind(kind reflect.Kind) bool {
+	return isIntKind(kind) || isUintKind(kind) || isFloatKind(kind)
+}
+
+// numberRat returns the exact value of a finite number of any kind.
+func numberRat(v reflect.Value) *big.Rat {
+	switch {
+	case isIntKind(v.Kind()):
+		return new(big.Rat).SetInt64(v.Int())
+	case isUintKind(v.Kind()):
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v.Uint()))
+	default:
+		return new(big.Rat).SetFloat64(v.Float())
+	}
+}
+
+// nonFinite returns the value of a float that is NaN or infinite.
+func nonFinite(v reflect.Value) (float64, bool) {
+	if !isFloatKind(v.Kind()) {
+		return 0, false
+	}
+	f := v.Float()
+	return f, math.IsNaN(f) || math.IsInf(f, 0)
+}
+
+// compareSign determine if a comparison passes for the sign of the difference of two values.
+func compareSign(sign int, operator string) (bool, error) {
+	valid, err := compareInt64(int64(sign), 0, operator)
+	if err != nil {
+		return false, fmt.Errorf("validator: compareSign unsupported operator %s", operator)
+	}
+	return valid, nil
+}
+
+// compareNumbers determine if a comparison passes between two numbers of any kinds, such as an int32 and a uint64.
+// Values are compared exactly, rather than through float64, which rounds integers above 2^53.
+func compareNumbers(first, second reflect.Value, operator string) (bool, error) {
+	firstKind, secondKind := first.Kind(), second.Kind()
+	switch {
+	case isIntKind(firstKind) && isIntKind(secondKind):
+		return compareInt64(first.Int(), second.Int(), operator)
+	case isUintKind(firstKind) && isUintKind(secondKind):
+		return compareUint64(first.Uint(), second.Uint(), operator)
+	case isFloatKind(firstKind) && isFloatKind(secondKind):
+		return compareFloat64(first.Float(), second.Float(), operator)
+	}
+
+	// NaN and infinities compare with any integer as they compare with zero.
+	if f, ok := nonFinite(first); ok {
+		return compareFloat64(f, 0, operator)
+	}
+	if f, ok := nonFinite(second); ok {
+		return compareFloat64(0, f, operator)
+	}
+	return compareSign(numberRat(first).Cmp(numberRat(second)), operator)
+}
+
+// compareNumberParam determine if a comparison passes between a number of any kind, including math/big numbers, and a
+// decimal param. Integers are
+// compared exactly, so int fields accept params such as 1.5 or 1e20, and uint fields negative params. Floats are compared
+// with the param rounded to their own precision, so a float32 0.1 equals 0.1.
+func compareNumberParam(v reflect.Value, param, operator string) (bool, error) {
+	if n, ok := bigNumber(v); ok {
+		return compareBigParam(n, param, operator)
+	}
+
+	switch {
+	case isIntKind(v.Kind()):
+		if p, err := ToInt(param); err == nil {
+			return compareInt64(v.Int(), p, operator)
+		}
+	case isUintKind(v.Kind()):
+		if p, err := ToUint(param); err == nil {
+			return compareUint64(v.Uint(), p, operator)
+		}
+	case isFloatKind(v.Kind()):
+		p, err := strconv.ParseFloat(param, v.Type().Bits())
+		if err != nil {
+			return false, err
+		}
+		return compareFloat64(v.Float(), p, operator)
+	default:
+		return false, fmt.Errorf("validator: %s is not a number", v.Type())
+	}
+
+	p, ok := parseDecimal(param)
+	if !ok {
+		return false, fmt.Errorf("validator: invalid number %s", param)
+	}
+	return compareSign(numberRat(v).Cmp(p), operator)
+}
+
+// numberBetween reports whether a number of any kind lies between the decimal params, in either order.
+func numberBetween(v reflect.Value, left, right string) (bool, error) {
+	var bounds [4]bool
+	for i, c := range []struct{ param, operator string }{{left, ">="}, {right, "<="}, {right, ">="}, {left, "<="}} {
+		valid, err := compareNumberParam(v, c.param, c.operator)
+		if err != nil {
+			return false, err
+		}
+		bounds[i] = valid
+	}
+	return (bounds[0] && bounds[1]) || (bounds[2] && bounds[3]), nil
+}
@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompareNumbers(t *testing.T) {
+	var tests = []struct {
+		first    interface{}
+		second   interface{}
+		operator string
+		expected bool
+	}{
+		{int64(9007199254740993), int64(9007199254740992), ">", true},
+		{int64(9007199254740993), float64(9007199254740992), ">", true},
+		{uint64(math.MaxUint64), int64(math.MaxInt64), ">", true},
+		{uint64(math.MaxUint64), float64(math.MaxUint64), "<", true},
+		{int64(-1), uint64(0), "<", true},
+		{int32(7), int64(7), "==", true},
+		{uint8(7), float32(7), "==", true},
+		{int64(1), 1.5, "<", true},
+		{math.Inf(1), uint64(math.MaxUint64), ">", true},
+		{int64(math.MinInt64), math.Inf(-1), ">", true},
+		{math.NaN(), int64(0), "==", false},
+		{math.NaN(), int64(0), "<=", false},
+	}
+	for _, test := range tests {
+		var actual bool
+		var err error
+		switch test.operator {
+		case ">":
+			actual, err = ValidateGt(test.first, test.second)
+		case "<":
+			actual, err = ValidateLt(test.first, test.second)
+		case "<=":
+			actual, err = ValidateLte(test.first, test.second)
+		case "==":
+			actual, err = ValidateSame(test.first, test.second)
+		}
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected %T(%v) %s %T(%v) to be %t, got %t %v", test.first, test.first, test.operator, test.second, test.second, test.expected, actual, err)
+		}
+	}
+
+	if _, err := ValidateGt("1", 0); err == nil {
+		t.Error("Expected an error comparing a string with a number")
+	}
+}
+
+func TestCompareNumberParams(t *testing.T) {
+	var tests = []struct {
+		value    interface{}
+		rule     func(interface{}, []string) (bool, error)
+		params   []string
+		expected bool
+	}{
+		{int64(9007199254740993), ValidateMax, []string{"9007199254740992"}, false},
+		{int64(9007199254740992), ValidateMax, []string{"9007199254740992"}, true},
+		{uint64(18446744073709551615), ValidateSize, []string{"18446744073709551615"}, true},
+		{uint64(18446744073709551615), ValidateGtParam, []string{"18446744073709551614"}, true},
+		{uint64(0), ValidateGtParam, []string{"-1"}, true},
+		{int64(math.MaxInt64), ValidateLtParam, []string{"1e20"}, true},
+		{2, ValidateLteParam, []string{"1.5"}, false},
+		{1, ValidateGteParam, []string{"0.5"}, true},
+		{float32(0.1), ValidateMax, []string{"0.1"}, true},
+		{float32(0.1), ValidateSize, []string{"0.1"}, true},
+		{0.1, ValidateMin, []string{"0.1"}, true},
+		{int64(9007199254740993), ValidateBetween, []string{"9007199254740993", "9007199254740994"}, true},
+		{int64(9007199254740992), ValidateBetween, []string{"9007199254740994", "9007199254740993"}, false},
+		{uint(5), ValidateBetween, []string{"10", "-10"}, true},
+	}
+	for _, test := range tests {
+		actual, err := test.rule(test.value, test.params)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected %T(%v) with %v to be %t, got %t %v", test.value, test.value, test.params, test.expected, actual, err)
+		}
+	}
+
+	if _, err := ValidateMax(1, []string{"one"}); err == nil {
+		t.Error("Expected an error for an invalid param")
+	}
+}
+
+func TestValidateMixedNumericFields(t *testing.T) {
+	type Order struct {
+		ID       int64
+		ParentID uint64 `valid:"lt=ID"`
+		Quantity int32
+		Stock    int64  `valid:"gte=Quantity"`
+		Limit    uint16 `valid:"same=Quantity"`
+	}
+
+	if err := ValidateStruct(Order{ID: 9007199254740993, ParentID: 9007199254740992, Quantity: 3, Stock: 3, Limit: 3}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	err := ValidateStruct(Order{ID: 9007199254740992, ParentID: 9007199254740993, Quantity: 3, Stock: 3, Limit: 3})
+	if err == nil || err.(Errors)[0].Error() != "The ParentID must be less than ID." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err = ValidateStruct(Order{ID: 1, Quantity: 4, Stock: 3, Limit: 4})
+	if err == nil || err.(Errors)[0].Error() != "The Stock must be greater than or equal Quantity." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package validator
+
+import (
+	_ "embed" // the common passwords are embedded from data
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:embed data/passwords.txt
+var commonPasswordsData string
+
+var (
+	commonPasswordsOnce sync.Once
+	commonPasswords     map[string]bool
+)
+
+// loadCommonPasswords returns the embedded common passwords in lowercase, parsing them on first use.
+func loadCommonPasswords() map[string]bool {
+	commonPasswordsOnce.Do(func() {
+		commonPasswords = readList(commonPasswordsData)
+	})
+	return commonPasswords
+}
+
+// minContainsFieldLength is the length below which the values of notContainsField are ignored, as short values
+// such as initials would reject too many passwords.
+const minContainsFieldLength = 3
+
+// PasswordPolicy is the policy of the password rule. Zero fields are not checked, except that passwords are always
+// checked against the embedded list of common passwords unless NoCommonBlocklist is set.
+type PasswordPolicy struct {
+	// MinLength is the minimum number of characters.
+	MinLength int
+	// RequireUpper, RequireLower, RequireDigit and RequireSymbol require a character of each class.
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// MaxRepeat is the maximum number of times a character may repeat in a row.
+	MaxRepeat int
+	// MinEntropy is the minimum estimated entropy in bits: the length times log2 of the size of the character classes used.
+	MinEntropy float64
+	// Blocklist are passwords rejected in addition to the common passwords, compared case-insensitively.
+	Blocklist []string
+	// NoCommonBlocklist disables the embedded list of common passwords.
+	NoCommonBlocklist bool
+}
+
+// DefaultPasswordPolicy is the policy of the password rule of Validators without a registered default policy.
+var DefaultPasswordPolicy = &PasswordPolicy{MinLength: 8}
+
+// RegisterPasswordPolicy registers a policy of the password rule. The policy named "" is used by password, and the
+// others by password=name.
+func (v *Validator) RegisterPasswordPolicy(name string, policy *PasswordPolicy) {
+	if v.passwordPolicies == nil {
+		v.passwordPolicies = map[string]*PasswordPolicy{}
+	}
+	v.passwordPolicies[name] = policy
+}
+
+// passwordPolicy returns the registered policy of the name, falling back to DefaultPasswordPolicy for "".
+func (v *Validator) passwordPolicy(name string) (*PasswordPolicy, error) {
+	if policy, ok := v.passwordPolicies[name]; ok {
+		return policy, nil
+	}
+	if name == "" {
+		return DefaultPasswordPolicy, nil
+	}
+	return nil, fmt.Errorf("validator: Password unknown policy %s", name)
+}
+
+// passwordEntropy estimates the entropy of the password in bits from its length and the character classes it uses.
+func passwordEntropy(password string) float64 {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range password {
+		switch {
+		case 'a' <= r && r <= 'z':
+			lower = true
+		case 'A' <= r && r <= 'Z':
+			upper = true
+		case '0' <= r && r <= '9':
+			digit = true
+		case r < utf8.RuneSelf:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	pool := 0
+	for _, class := range []struct {
+		used bool
+		size int
+	}{{lower, 26}, {upper, 26}, {digit, 10}, {symbol, 33}, {other, 100}} {
+		if class.used {
+			pool += class.size
+		}
+	}
+	if pool == 0 {
+		return 0
+	}
+	return float64(utf8.RuneCountInString(password)) * math.Log2(float64(pool))
+}
+
+// maxRepeat returns the longest run of the same character in the password.
+func maxRepeat(password string) int {
+	longest, run := 0, 0
+	var last rune = -1
+	for _, r := range password {
+		if r == last {
+			run++
+		} else {
+			run = 1
+			last = r
+		}
+		if run > longest {
+			longest = run
+		}
+	}
+	return longest
+}
+
+// Check returns the requirements of the policy the password fails as message parameters, keyed by the name of the
+// PasswordPolicy field with its value, such as MinLength 12. The password must also not contain any of others,
+// compared case-insensitively; a failure is keyed NotContains with the value. The result is empty for a valid password.
+func (p *PasswordPolicy) Check(password string, others ...string) MessageParameters {
+	var reasons MessageParameters
+	fail := func(key, value string) {
+		reasons = append(reasons, messageParameter{Key: key, Value: value})
+	}
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		fail("MinLength", strconv.Itoa(p.MinLength))
+	}
+	for _, class := range []struct {
+		key      string
+		required bool
+		is       func(rune) bool
+	}{
+		{"RequireUpper", p.RequireUpper, unicode.IsUpper},
+		{"RequireLower", p.RequireLower, unicode.IsLower},
+		{"RequireDigit", p.RequireDigit, unicode.IsDigit},
+		{"RequireSymbol", p.RequireSymbol, func(r rune) bool { return unicode.IsPunct(r) || unicode.IsSymbol(r) }},
+	} {
+		if class.required && strings.IndexFunc(password, class.is) < 0 {
+			fail(class.key, "true")
+		}
+	}
+	if p.MaxRepeat > 0 && maxRepeat(password) > p.MaxRepeat {
+		fail("MaxRepeat", strconv.Itoa(p.MaxRepeat))
+	}
+	if p.MinEntropy > 0 && passwordEntropy(password) < p.MinEntropy {
+		fail("MinEntropy", strconv.FormatFloat(p.MinEntropy, 'f', -1, 64))
+	}
+
+	lower := strings.ToLower(password)
+	blocked := !p.NoCommonBlocklist && loadCommonPasswords()[lower]
+	for _, s := range p.Blocklist {
+		blocked = blocked || strings.ToLower(s) == lower
+	}
+	if blocked {
+		fail("Blocklist", "true")
+	}
+
+	for _, other := range others {
+		if containsOther(password, other) {
+			fail("NotContains", other)
+		}
+	}
+	return reasons
+}
+
+// containsOther reports whether the password contains the value of another field, ignoring case and short values.
+func containsOther(password, other string) bool {
+	return utf8.RuneCountInString(other) >= minContainsFieldLength && strings.Contains(strings.ToLower(password), strings.ToLower(other))
+}
+
+// ValidatePassword check if the string satisfies the password policy, or DefaultPasswordPolicy when policy is nil.
+// Empty string is valid.
+func ValidatePassword(str string, policy *PasswordPolicy) bool {
+	if IsNull(str) {
+		return true
+	}
+	if policy == nil {
+		policy = DefaultPasswordPolicy
+	}
+	return len(policy.Check(str)) == 0
+}
+
+// checkPassword validates the password rule. Its params are an optional policy name and notContainsField=Field options
+// naming fields whose values, and the local part of e-mail addresses, the password must not contain. The password is
+// kept out of the error.
+func (v *Validator) checkPassword(validTag *ValidTag, f *field, value, o reflect.Value, name, structName string) error {
+	if value.Kind() != reflect.String {
+		return v.passwordError(validTag, f, o, name, structName, nil, fmt.Errorf("validator: Password unsupported type %s", value.Type()))
+	}
+
+	policyName := ""
+	var fields []string
+	for _, param := range validTag.params {
+		option, fieldName, ok := strings.Cut(param, "=")
+		switch {
+		case !ok:
+			policyName = param
+		case option == "notContainsField":
+			fields = append(fields, fieldName)
+		default:
+			return v.passwordError(validTag, f, o, name, structName, nil, fmt.Errorf("validator: Password unknown option %s", option))
+		}
+	}
+	policy, err := v.passwordPolicy(policyName)
+	if err != nil {
+		return v.passwordError(validTag, f, o, name, structName, nil, err)
+	}
+	if IsNull(value.String()) {
+		return nil
+	}
+
+	password := value.String()
+	reasons := policy.Check(password)
+	for _, fieldName := range fields {
+		anotherField, err := findField(fieldName, o)
+		if err != nil || !anotherField.IsValid() {
+			return v.passwordError(validTag, f, o, name, structName, nil, fmt.Errorf("validator: Password field %s not found", fieldName))
+		}
+		other := ToString(anotherField.Interface())
+		local, _, _ := strings.Cut(other, "@")
+		if containsOther(password, other) || containsOther(password, local) {
+			reasons = append(reasons, messageParameter{Key: "NotContainsField", Value: getDisplayableAttribute(o, fieldName)})
+		}
+	}
+	if len(reasons) == 0 {
+		return nil
+	}
+	return v.passwordError(validTag, f, o, name, structName, reasons, nil)
+}
+
+// passwordError returns the error of the password rule with the failed requirements as message parameters.
+func (v *Validator) passwordError(validTag *ValidTag, f *field, o reflect.Value, name, structName string, reasons MessageParameters, funcError error) error {
+	return v.formatsMessages(v.createFieldError(
+		name, structName, validTag.name, validTag.messageName,
+		append(parseValidatorMessageParameters(validTag, o), reasons...),
+		f.attribute, f.defaultAttribute,
+		"", funcError,
+	))
+}
@@ -0,0 +1,110 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	policy := &PasswordPolicy{
+		MinLength:     10,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeat:     2,
+		MinEntropy:    50,
+		Blocklist:     []string{"Acme-Corp-2024!"},
+	}
+	var tests = []struct {
+		password string
+		others   []string
+		expected []string
+	}{
+		{"Tr0ub4dor&3x", nil, nil},
+		{"short", nil, []string{"MinLength", "RequireUpper", "RequireDigit", "RequireSymbol", "MinEntropy"}},
+		{"Paaassword1!", nil, []string{"MaxRepeat"}},
+		{"acme-corp-2024!", nil, []string{"RequireUpper", "Blocklist"}},
+		{"Password123", nil, []string{"RequireSymbol", "Blocklist"}},
+		{"Tr0ub4dor&3x", []string{"ub4dor"}, []string{"NotContains"}},
+		{"Tr0ub4dor&3x", []string{"3x"}, nil},
+	}
+	for _, test := range tests {
+		var keys []string
+		for _, reason := range policy.Check(test.password, test.others...) {
+			keys = append(keys, reason.Key)
+		}
+		if !reflect.DeepEqual(keys, test.expected) {
+			t.Errorf("Expected Check(%q) to fail %v, got %v", test.password, test.expected, keys)
+		}
+	}
+
+	if reasons := (&PasswordPolicy{MinLength: 12}).Check("abc"); len(reasons) != 1 || reasons[0].Value != "12" {
+		t.Errorf("Unexpected reasons: %v", reasons)
+	}
+	if ValidatePassword("password1", DefaultPasswordPolicy) || ValidatePassword("QWERTY", DefaultPasswordPolicy) {
+		t.Error("Expected common passwords to be invalid")
+	}
+	if !ValidatePassword("password1", &PasswordPolicy{NoCommonBlocklist: true}) {
+		t.Error("Expected common password to be valid without the blocklist")
+	}
+	if !ValidatePassword("", DefaultPasswordPolicy) || !ValidatePassword("correct horse battery", DefaultPasswordPolicy) {
+		t.Error("Expected password to be valid")
+	}
+	if ValidatePassword("short", nil) || !ValidatePassword("correct horse battery", nil) {
+		t.Error("Expected a nil policy to fall back to DefaultPasswordPolicy")
+	}
+}
+
+func TestValidatePasswordRule(t *testing.T) {
+	type Signup struct {
+		Username string
+		Email    string
+		Password string `valid:"password=notContainsField=Username|notContainsField=Email"`
+		PIN      string `valid:"password=pin"`
+	}
+
+	v := New()
+	v.RegisterPasswordPolicy("", &PasswordPolicy{MinLength: 10, RequireDigit: true})
+	v.RegisterPasswordPolicy("pin", &PasswordPolicy{MinLength: 6, NoCommonBlocklist: true})
+
+	if err := v.ValidateStruct(&Signup{Username: "alice", Email: "alice@example.com", Password: "purple-rain-42", PIN: "482913"}, nil, nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	err := v.ValidateStruct(&Signup{Username: "alice", Email: "wonder@example.com", Password: "Wonderland-alice"}, nil, nil)
+	if err == nil {
+		t.Fatal("Expected password error")
+	}
+	fieldErr := err.(Errors)[0].(*FieldError)
+	if fieldErr.Message != "The Password is not strong enough." || fieldErr.Value != "" {
+		t.Errorf("Unexpected error: %v (value %q)", fieldErr, fieldErr.Value)
+	}
+	expected := MessageParameters{
+		{Key: "RequireDigit", Value: "true"},
+		{Key: "NotContainsField", Value: "Username"},
+		{Key: "NotContainsField", Value: "Email"},
+	}
+	if !reflect.DeepEqual(fieldErr.MessageParameters, expected) {
+		t.Errorf("Expected message parameters %v, got %v", expected, fieldErr.MessageParameters)
+	}
+
+	err = v.ValidateStruct(&Signup{PIN: "1234"}, nil, nil)
+	if err == nil || err.(Errors)[0].(*FieldError).MessageParameters[0].Key != "MinLength" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	// The package-level validator falls back to DefaultPasswordPolicy and rejects unknown policies.
+	type Account struct {
+		Password string `valid:"password"`
+		Secret   string `valid:"password=unknown"`
+	}
+	err = ValidateStruct(&Account{Password: "letmein"})
+	if err == nil || err.(Errors)[0].(*FieldError).Tag != "password" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err = ValidateStruct(&Account{Secret: "correct horse battery"})
+	if err == nil || !err.(Errors)[0].(*FieldError).HasFuncError() {
+		t.Errorf("Expected error for unknown policy, got %v", err)
+	}
+}
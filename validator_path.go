@@ -0,0 +1,170 @@
+package validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SegmentKind is the kind of a PathSegment.
+type SegmentKind uint8
+
+const (
+	// FieldSegment is a struct field, named by its JSON name.
+	FieldSegment SegmentKind = iota
+	// IndexSegment is an element of a slice or array.
+	IndexSegment
+	// KeySegment is an entry of a map.
+	KeySegment
+)
+
+var segmentKindNames = [...]string{"field", "index", "key"}
+
+// String returns the name of the kind.
+func (k SegmentKind) String() string {
+	if int(k) >= len(segmentKindNames) {
+		return "SegmentKind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return segmentKindNames[k]
+}
+
+// MarshalText encodes the kind as its name.
+func (k SegmentKind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText decodes the kind from its name.
+func (k *SegmentKind) UnmarshalText(text []byte) error {
+	for i, name := range segmentKindNames {
+		if string(text) == name {
+			*k = SegmentKind(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("validator: unknown segment kind %s", text)
+}
+
+// PathSegment is a step of the path from the validated struct to a field: a field, an index or a map key.
+type PathSegment struct {
+	Kind SegmentKind `json:"kind"`
+	// Name is the JSON name of a field or the key of a map entry.
+	Name string `json:"name,omitempty"`
+	// Index is the index of a slice or array element. It is only encoded for IndexSegment.
+	Index int `json:"index"`
+}
+
+// MarshalJSON encodes the segment with the index only for IndexSegment, so that index 0 is kept and other segments
+// carry none.
+func (s PathSegment) MarshalJSON() ([]byte, error) {
+	segment := struct {
+		Kind  SegmentKind `json:"kind"`
+		Name  string      `json:"name,omitempty"`
+		Index *int        `json:"index,omitempty"`
+	}{Kind: s.Kind, Name: s.Name}
+	if s.Kind == IndexSegment {
+		segment.Index = &s.Index
+	}
+	return json.Marshal(segment)
+}
+
+// PathFormatter formats the path of a field as the Name of its FieldError.
+type PathFormatter func(path []PathSegment) string
+
+// DotPath formats a path as addresses.0.city. It is the default, though map keys containing dots make it ambiguous.
+func DotPath(path []PathSegment) string {
+	var b strings.Builder
+	for i, s := range path {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		if s.Kind == IndexSegment {
+			b.WriteString(strconv.Itoa(s.Index))
+		} else {
+			b.WriteString(s.Name)
+		}
+	}
+	return b.String()
+}
+
+// BracketPath formats a path as addresses[0].city, with map keys quoted as in meta["a.b"].
+func BracketPath(path []PathSegment) string {
+	var b strings.Builder
+	for i, s := range path {
+		switch s.Kind {
+		case IndexSegment:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(s.Index))
+			b.WriteByte(']')
+		case KeySegment:
+			b.WriteByte('[')
+			b.WriteString(strconv.Quote(s.Name))
+			b.WriteByte(']')
+		default:
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(s.Name)
+		}
+	}
+	return b.String()
+}
+
+// jsonPointerEscaper escapes the reference tokens of RFC 6901.
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// JSONPointerPath formats a path as the JSON Pointer of RFC 6901, such as /addresses/0/city.
+func JSONPointerPath(path []PathSegment) string {
+	var b strings.Builder
+	for _, s := range path {
+		b.WriteByte('/')
+		if s.Kind == IndexSegment {
+			b.WriteString(strconv.Itoa(s.Index))
+		} else {
+			b.WriteString(jsonPointerEscaper.Replace(s.Name))
+		}
+	}
+	return b.String()
+}
+
+// prependPath prepends the segment to the paths of the field errors of err, as they return from a nested field.
+func prependPath(err error, segment PathSegment) {
+	switch e := err.(type) {
+	case *FieldError:
+		e.Path = append([]PathSegment{segment}, e.Path...)
+	case Errors:
+		for _, fe := range e {
+			prependPath(fe, segment)
+		}
+	}
+}
+
+// namespacePath returns the segments of a dot namespace passed to ValidateStruct, such as user.addresses.0., in which
+// numbers are indices.
+func namespacePath(jsonNamespace []byte) []PathSegment {
+	var path []PathSegment
+	for _, name := range strings.Split(string(jsonNamespace), ".") {
+		if name == "" {
+			continue
+		}
+		if index, err := strconv.Atoi(name); err == nil && index >= 0 {
+			path = append(path, PathSegment{Kind: IndexSegment, Index: index})
+		} else {
+			path = append(path, PathSegment{Kind: FieldSegment, Name: name})
+		}
+	}
+	return path
+}
+
+// formatPaths names the field errors of err with the PathFormat of the Validator, formatting the path of the
+// namespace passed to ValidateStruct before their own. The Path of the errors stays relative to the validated struct.
+func (v *Validator) formatPaths(err error, namespace []PathSegment) {
+	switch e := err.(type) {
+	case *FieldError:
+		e.Name = v.PathFormat(append(namespace[:len(namespace):len(namespace)], e.Path...))
+	case Errors:
+		for _, fe := range e {
+			v.formatPaths(fe, namespace)
+		}
+	}
+}
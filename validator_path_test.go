@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+type pathAddress struct {
+	City string `json:"city" valid:"required"`
+}
+
+type pathUser struct {
+	Name      string                  `json:"name" valid:"required"`
+	Addresses []pathAddress           `json:"addresses"`
+	Meta      map[string]*pathAddress `json:"meta" valid:"omitempty"`
+	Home      *pathAddress            `json:"home" valid:"omitempty"`
+}
+
+func TestFieldErrorPath(t *testing.T) {
+	var tests = []struct {
+		param    pathUser
+		path     []PathSegment
+		dot      string
+		bracket  string
+		pointer  string
+		expected string
+	}{
+		{
+			pathUser{},
+			[]PathSegment{{Kind: FieldSegment, Name: "name"}},
+			"name", "name", "/name", "The Name field is required.",
+		},
+		{
+			pathUser{Name: "a", Addresses: []pathAddress{{City: "x"}, {}}},
+			[]PathSegment{{Kind: FieldSegment, Name: "addresses"}, {Kind: IndexSegment, Index: 1}, {Kind: FieldSegment, Name: "city"}},
+			"addresses.1.city", "addresses[1].city", "/addresses/1/city", "The City field is required.",
+		},
+		{
+			pathUser{Name: "a", Meta: map[string]*pathAddress{"a.b/c~d": {}}},
+			[]PathSegment{{Kind: FieldSegment, Name: "meta"}, {Kind: KeySegment, Name: "a.b/c~d"}, {Kind: FieldSegment, Name: "city"}},
+			"meta.a.b/c~d.city", `meta["a.b/c~d"].city`, "/meta/a.b~1c~0d/city", "The City field is required.",
+		},
+		{
+			pathUser{Name: "a", Home: &pathAddress{}},
+			[]PathSegment{{Kind: FieldSegment, Name: "home"}, {Kind: FieldSegment, Name: "city"}},
+			"home.city", "home.city", "/home/city", "The City field is required.",
+		},
+	}
+	for _, test := range tests {
+		for _, format := range []struct {
+			formatter PathFormatter
+			expected  string
+		}{{nil, test.dot}, {DotPath, test.dot}, {BracketPath, test.bracket}, {JSONPointerPath, test.pointer}} {
+			v := New()
+			v.PathFormat = format.formatter
+			err := v.ValidateStruct(test.param, nil, nil)
+			if err == nil {
+				t.Fatalf("Expected an error for %+v", test.param)
+			}
+			fieldErr := err.(Errors)[0].(*FieldError)
+			if fieldErr.Name != format.expected || fieldErr.Error() != test.expected {
+				t.Errorf("Expected %q %q, got %q %q", format.expected, test.expected, fieldErr.Name, fieldErr.Error())
+			}
+			if !reflect.DeepEqual(fieldErr.Path, test.path) {
+				t.Errorf("Expected the path %+v, got %+v", test.path, fieldErr.Path)
+			}
+		}
+	}
+}
+
+func TestPathFormatNamespace(t *testing.T) {
+	v := New()
+	v.PathFormat = JSONPointerPath
+	err := v.ValidateStruct(pathUser{}, []byte("body.items.0."), nil)
+	if err == nil || err.(Errors)[0].(*FieldError).Name != "/body/items/0/name" {
+		t.Errorf("Expected the namespace to prefix the name, got %v", err)
+	}
+	if !err.(Errors).HasFieldError("/body/items/0/name") {
+		t.Error("Expected HasFieldError to find the formatted name")
+	}
+
+	v.PathFormat = BracketPath
+	err = v.ValidateStruct(pathUser{}, []byte("body.items.0."), nil)
+	if err == nil || err.(Errors)[0].(*FieldError).Name != "body.items[0].name" {
+		t.Errorf("Expected the namespace to be formatted as brackets, got %v", err)
+	}
+}
+
+func TestPathSegmentJSON(t *testing.T) {
+	path := []PathSegment{{Kind: FieldSegment, Name: "addresses"}, {Kind: IndexSegment, Index: 0}, {Kind: KeySegment, Name: "a"}}
+	data, err := json.Marshal(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"kind":"field","name":"addresses"},{"kind":"index","index":0},{"kind":"key","name":"a"}]`
+	if string(data) != expected {
+		t.Errorf("Expected %s, got %s", expected, data)
+	}
+	var decoded []PathSegment
+	if err := json.Unmarshal(data, &decoded); err != nil || !reflect.DeepEqual(decoded, path) {
+		t.Errorf("Expected %+v, got %+v %v", path, decoded, err)
+	}
+	if err := json.Unmarshal([]byte(`[{"kind":"other"}]`), &decoded); err == nil {
+		t.Error("Expected an error for an unknown kind")
+	}
+}
@@ -0,0 +1,236 @@
+package validator
+
+import (
+	_ "embed" // the numbering plans are embedded from data
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+//go:embed data/phone.csv
+var phoneData string
+
+// minPhoneLength is the length of the shortest national significant numbers, and maxPhoneLength the number of
+// digits of E.164 numbers with their calling code.
+const (
+	minPhoneLength = 4
+	maxPhoneLength = 15
+)
+
+// phonePlan is the numbering plan of a region: its calling code, trunk prefix, and the lengths and leading digits
+// of its national significant numbers.
+type phonePlan struct {
+	region         string
+	callingCode    string
+	trunkPrefix    string
+	lengths        []int
+	prefixes       []string
+	mobilePrefixes []string
+	mobileLengths  []int
+}
+
+var (
+	phonePlansOnce sync.Once
+	// phonePlans maps regions to their plans, and phonePlansByCode calling codes to the plans sharing them.
+	phonePlans       map[string]*phonePlan
+	phonePlansByCode map[string][]*phonePlan
+)
+
+// splitList splits a "|" separated column of the embedded data.
+func splitList(column string) []string {
+	if column == "" {
+		return nil
+	}
+	return strings.Split(column, "|")
+}
+
+// splitLengths splits a "|" separated column of lengths of the embedded data.
+func splitLengths(column string) []int {
+	var lengths []int
+	for _, s := range splitList(column) {
+		n, _ := strconv.Atoi(s)
+		lengths = append(lengths, n)
+	}
+	return lengths
+}
+
+// loadPhonePlans returns the numbering plans, parsing them on first use.
+func loadPhonePlans() (map[string]*phonePlan, map[string][]*phonePlan) {
+	phonePlansOnce.Do(func() {
+		phonePlans = map[string]*phonePlan{}
+		phonePlansByCode = map[string][]*phonePlan{}
+		for _, record := range readTable("phone", phoneData) {
+			plan := &phonePlan{
+				region:         record[0],
+				callingCode:    record[1],
+				trunkPrefix:    record[2],
+				lengths:        splitLengths(record[3]),
+				prefixes:       splitList(record[4]),
+				mobilePrefixes: splitList(record[5]),
+				mobileLengths:  splitLengths(record[6]),
+			}
+			phonePlans[plan.region] = plan
+			phonePlansByCode[plan.callingCode] = append(phonePlansByCode[plan.callingCode], plan)
+		}
+	})
+	return phonePlans, phonePlansByCode
+}
+
+// hasLength reports whether the length of number is one of lengths.
+func hasLength(number string, lengths []int) bool {
+	for _, length := range lengths {
+		if len(number) == length {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPrefix reports whether number starts with one of prefixes.
+func hasPrefix(number string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(number, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// valid reports whether the national significant number belongs to the plan.
+// Plans without lengths only bound the length of the number.
+func (p *phonePlan) valid(number string) bool {
+	if len(p.lengths) == 0 {
+		return len(number) >= minPhoneLength && len(p.callingCode)+len(number) <= maxPhoneLength &&
+			(len(p.prefixes) == 0 || hasPrefix(number, p.prefixes))
+	}
+	return hasLength(number, p.lengths) && hasPrefix(number, p.prefixes)
+}
+
+// mobile reports whether the national significant number is a mobile number of the plan.
+// Plans that do not distinguish mobile numbers accept any valid number.
+func (p *phonePlan) mobile(number string) bool {
+	if !p.valid(number) {
+		return false
+	}
+	return len(p.mobilePrefixes) == 0 || (hasLength(number, p.mobileLengths) && hasPrefix(number, p.mobilePrefixes))
+}
+
+// parsePhone finds the plan of a phone number and its national significant number. The number is either international,
+// starting with + and the calling code, or national in one of the regions, with or without the trunk prefix.
+// Spaces, dashes, dots and parentheses are ignored. When regions are given, the number must belong to one of them.
+func parsePhone(str string, regions []string) (*phonePlan, string, bool) {
+	number := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(str)
+	international := strings.HasPrefix(number, "+")
+	number = strings.TrimPrefix(number, "+")
+	if number == "" || !isDigits(number) {
+		return nil, "", false
+	}
+
+	plans, plansByCode := loadPhonePlans()
+	if international {
+		for i := 1; i <= 3 && i < len(number); i++ {
+			for _, plan := range plansByCode[number[:i]] {
+				if (len(regions) == 0 || InString(plan.region, regions)) && plan.valid(number[i:]) {
+					return plan, number[i:], true
+				}
+			}
+		}
+		return nil, "", false
+	}
+
+	for _, region := range regions {
+		plan, ok := plans[region]
+		if !ok {
+			continue
+		}
+		if plan.trunkPrefix != "" && strings.HasPrefix(number, plan.trunkPrefix) && plan.valid(number[len(plan.trunkPrefix):]) {
+			return plan, number[len(plan.trunkPrefix):], true
+		}
+		if plan.valid(number) {
+			return plan, number, true
+		}
+	}
+	return nil, "", false
+}
+
+// NormalizePhone returns the E.164 form of a phone number, such as +85291234567. The number is either international,
+// or national in one of the regions, such as HK or CN. Spaces, dashes, dots and parentheses are ignored.
+func NormalizePhone(str string, regions ...string) (string, error) {
+	plan, number, ok := parsePhone(str, regions)
+	if !ok {
+		return "", fmt.Errorf("validator: invalid phone number %s", str)
+	}
+	return "+" + plan.callingCode + number, nil
+}
+
+// ValidatePhone check if the string is a phone number of one of the regions, such as HK, CN or US, in international or
+// national form. Without regions, the number must be international. Empty string is valid.
+func ValidatePhone(str string, regions ...string) bool {
+	if IsNull(str) {
+		return true
+	}
+	_, _, ok := parsePhone(str, regions)
+	return ok
+}
+
+// ValidateMobilePhone check if the string is a mobile phone number of one of the regions, in international or national form.
+// Regions that do not distinguish mobile numbers, such as US, or have no numbering plan beyond their calling code accept
+// any valid number. Empty string is valid.
+func ValidateMobilePhone(str string, regions ...string) bool {
+	if IsNull(str) {
+		return true
+	}
+	plan, number, ok := parsePhone(str, regions)
+	return ok && plan.mobile(number)
+}
+
+// ValidateE164 check if the string is a phone number in E.164 format, a + and up to 15 digits without separators.
+// Numbers of calling codes in the numbering plans must also be valid in them, and numbers of other calling codes, such
+// as those of international networks, must have room for a 3 digit calling code and a national number. Empty string
+// is valid.
+func ValidateE164(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if len(str) > maxPhoneLength+1 || str[0] != '+' || len(str) < 2 || str[1] == '0' || !isDigits(str[1:]) {
+		return false
+	}
+
+	_, plansByCode := loadPhonePlans()
+	for i := 1; i <= 3 && i < len(str); i++ {
+		if _, ok := plansByCode[str[1:1+i]]; ok {
+			_, _, valid := parsePhone(str, nil)
+			return valid
+		}
+	}
+	return len(str)-1 >= 3+minPhoneLength
+}
+
+// validatePhone is the validation function for validating the string is a phone number of one of the regions of params.
+// The option "mobile" only accepts mobile numbers.
+func validatePhone(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: Phone unsupported type %s", v.Type())
+	}
+
+	plans, _ := loadPhonePlans()
+	mobile := false
+	regions := make([]string, 0, len(params))
+	for _, param := range params {
+		if param == "mobile" {
+			mobile = true
+			continue
+		}
+		if _, ok := plans[param]; !ok {
+			return false, fmt.Errorf("validator: Phone unknown region %s", param)
+		}
+		regions = append(regions, param)
+	}
+
+	if mobile {
+		return ValidateMobilePhone(v.String(), regions...), nil
+	}
+	return ValidatePhone(v.String(), regions...), nil
+}
@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidatePhone(t *testing.T) {
+	var tests = []struct {
+		param    string
+		regions  []string
+		mobile   bool
+		expected bool
+	}{
+		{"", []string{"HK"}, false, true},
+		{"9123 4567", []string{"HK"}, false, true},
+		{"2123-4567", []string{"HK"}, false, true},
+		{"+852 9123 4567", []string{"HK"}, false, true},
+		{"+852 9123 4567", nil, false, true},
+		{"9123 4567", nil, false, false},
+		{"0123 4567", []string{"HK"}, false, false},
+		{"9123 456", []string{"HK"}, false, false},
+		{"138 0013 8000", []string{"CN"}, false, true},
+		{"010 1234 5678", []string{"CN"}, false, true},
+		{"+86 138 0013 8000", []string{"HK", "CN"}, false, true},
+		{"+86 138 0013 8000", []string{"HK", "US"}, false, false},
+		{"(212) 555-0123", []string{"US"}, false, true},
+		{"1 212 555 0123", []string{"US"}, false, true},
+		{"+1 212 555 0123", []string{"US"}, false, true},
+		{"(012) 555-0123", []string{"US"}, false, false},
+		{"9123 4567", []string{"HK"}, true, true},
+		{"2123 4567", []string{"HK"}, true, false},
+		{"138 0013 8000", []string{"CN"}, true, true},
+		{"010 1234 5678", []string{"CN"}, true, false},
+		{"(212) 555-0123", []string{"US"}, true, true},
+		{"+852 9123 4567x", []string{"HK"}, false, false},
+		{"+852 +9123 4567", []string{"HK"}, false, false},
+		{"+1 268 464 1234", []string{"AG"}, false, true},
+		{"+1 212 555 0123", []string{"AG"}, false, false},
+		{"01481 123456", []string{"GG"}, false, true},
+		{"+299 123456", []string{"GL"}, false, true},
+		{"+299 123", []string{"GL"}, false, false},
+		{"+299 123456", []string{"GL"}, true, true},
+	}
+	for _, test := range tests {
+		validate := ValidatePhone
+		if test.mobile {
+			validate = ValidateMobilePhone
+		}
+		if actual := validate(test.param, test.regions...); actual != test.expected {
+			t.Errorf("Expected validation of %q in %v (mobile %v) to be %v, got %v", test.param, test.regions, test.mobile, test.expected, actual)
+		}
+	}
+}
+
+func TestNormalizePhone(t *testing.T) {
+	var tests = []struct {
+		param    string
+		regions  []string
+		expected string
+	}{
+		{"9123 4567", []string{"HK"}, "+85291234567"},
+		{"+852 9123-4567", nil, "+85291234567"},
+		{"010 1234 5678", []string{"CN"}, "+861012345678"},
+		{"138.0013.8000", []string{"HK", "CN"}, "+8613800138000"},
+		{"1 (212) 555-0123", []string{"US"}, "+12125550123"},
+		{"+44 20 7946 0958", nil, "+442079460958"},
+	}
+	for _, test := range tests {
+		actual, err := NormalizePhone(test.param, test.regions...)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected NormalizePhone(%q, %v) to be %q, got %q (%v)", test.param, test.regions, test.expected, actual, err)
+		}
+	}
+	if _, err := NormalizePhone("12345", "HK"); err == nil {
+		t.Error("Expected error for invalid phone number")
+	}
+}
+
+func TestValidateE164(t *testing.T) {
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"+85291234567", true},
+		{"+8613800138000", true},
+		{"+12125550123", true},
+		{"+2348012345678", true},
+		{"+8529123456", false},
+		{"+852 9123 4567", false},
+		{"85291234567", false},
+		{"+0123456789", false},
+		{"+1234567890123456", false},
+		{"+", false},
+		{"+299", false},
+		{"+2991", false},
+		{"+299123456", true},
+		{"+80012345678", true},
+		{"+80012", false},
+	}
+	for _, test := range tests {
+		if actual := ValidateE164(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidatePhoneRule(t *testing.T) {
+	type Contact struct {
+		Phone  string `valid:"phone=HK|CN|US"`
+		Mobile string `valid:"phone=HK|mobile"`
+	}
+	if err := ValidateStruct(&Contact{Phone: "+1 212 555 0123", Mobile: "9123 4567"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Contact{Phone: "12345"})
+	if err == nil || err.(Errors)[0].Error() != "The Phone must be a valid phone number." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err = ValidateStruct(&Contact{Mobile: "2123 4567"})
+	if err == nil || err.(Errors)[0].Error() != "The Mobile must be a valid phone number." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if _, err := validatePhone(reflect.ValueOf("9123 4567"), []string{"XX"}); err == nil {
+		t.Error("Expected error for unknown region")
+	}
+	if _, err := validatePhone(reflect.ValueOf(91234567), []string{"HK"}); err == nil {
+		t.Error("Expected error for unsupported type")
+	}
+}
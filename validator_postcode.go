@@ -0,0 +1,102 @@
+package validator
+
+import (
+	_ "embed" // the postal code formats are embedded from data
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed data/postcode.csv
+var postcodeData string
+
+var (
+	postcodesOnce sync.Once
+	// postcodes maps ISO 3166-1 countries to their postal code format, or nil when the country has no postal codes.
+	postcodes map[string]*regexp.Regexp
+)
+
+// loadPostcodes returns the postal code formats, compiling them on first use.
+func loadPostcodes() map[string]*regexp.Regexp {
+	postcodesOnce.Do(func() {
+		postcodes = map[string]*regexp.Regexp{}
+		for _, record := range readTable("postcode", postcodeData) {
+			var rx *regexp.Regexp
+			if record[1] != "" {
+				rx = regexp.MustCompile("^(?:" + record[1] + ")$")
+			}
+			postcodes[record[0]] = rx
+		}
+	})
+	return postcodes
+}
+
+// HasPostcode reports whether the ISO 3166-1 alpha-2 country uses postal codes. HK, for example, does not.
+func HasPostcode(country string) bool {
+	return loadPostcodes()[country] != nil
+}
+
+// ValidatePostcode check if the string is a postal code of one of the ISO 3166-1 alpha-2 countries, such as GB or US.
+// Letters may be of any case. Countries without postal codes, such as HK, accept any value, as there is nothing to check;
+// use HasPostcode to tell them apart. Empty string is valid.
+func ValidatePostcode(str string, countries ...string) (bool, error) {
+	if len(countries) == 0 {
+		return false, fmt.Errorf("validator: Postcode requires at least one country")
+	}
+	formats := loadPostcodes()
+	for _, country := range countries {
+		if _, ok := formats[country]; !ok {
+			return false, fmt.Errorf("validator: Postcode unknown country %s", country)
+		}
+	}
+	if IsNull(str) {
+		return true, nil
+	}
+
+	code := strings.ToUpper(str)
+	for _, country := range countries {
+		if rx := formats[country]; rx == nil || rx.MatchString(code) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validatePostcode is the validation function for validating the string is a postal code of one of the countries of params.
+func validatePostcode(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: Postcode unsupported type %s", v.Type())
+	}
+	return ValidatePostcode(v.String(), params...)
+}
+
+// validatePostcodeField is the validation function for validating the string is a postal code of the country held by
+// another field. The value is not checked while the country is empty, and is invalid for an unknown country.
+func validatePostcodeField(v, countryField reflect.Value) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: PostcodeField unsupported type %s", v.Type())
+	}
+	if !countryField.IsValid() {
+		return false, fmt.Errorf("validator: PostcodeField country field not found")
+	}
+	for countryField.Kind() == reflect.Ptr || countryField.Kind() == reflect.Interface {
+		if countryField.IsNil() {
+			return true, nil
+		}
+		countryField = countryField.Elem()
+	}
+	if countryField.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: PostcodeField country must be a string")
+	}
+
+	country := countryField.String()
+	if country == "" {
+		return true, nil
+	}
+	if _, ok := loadPostcodes()[country]; !ok {
+		return false, nil
+	}
+	return ValidatePostcode(v.String(), country)
+}
@@ -0,0 +1,104 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidatePostcode(t *testing.T) {
+	var tests = []struct {
+		param     string
+		countries []string
+		expected  bool
+	}{
+		{"", []string{"GB"}, true},
+		{"SW1A 1AA", []string{"GB"}, true},
+		{"sw1a1aa", []string{"GB"}, true},
+		{"GIR 0AA", []string{"GB"}, true},
+		{"SW1A 1CA", []string{"GB"}, false},
+		{"12345", []string{"GB"}, false},
+		{"94105", []string{"US"}, true},
+		{"94105-1234", []string{"US"}, true},
+		{"9410", []string{"US"}, false},
+		{"100080", []string{"CN"}, true},
+		{"10008", []string{"CN"}, false},
+		{"K1A 0B1", []string{"CA"}, true},
+		{"D02 X285", []string{"IE"}, true},
+		{"A65", []string{"IE"}, false},
+		{"1011 AB", []string{"NL"}, true},
+		{"100-0001", []string{"JP"}, true},
+		{"00-950", []string{"PL"}, true},
+		{"1000-001", []string{"PT"}, true},
+		{"12345", []string{"GB", "US"}, true},
+		{"SW1A 1AA", []string{"CN", "US"}, false},
+		{"anything", []string{"HK"}, true},
+		{"12345", []string{"GB", "HK"}, true},
+	}
+	for _, test := range tests {
+		actual, err := ValidatePostcode(test.param, test.countries...)
+		if err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidatePostcode(%q, %v) to be %v, got %v", test.param, test.countries, test.expected, actual)
+		}
+	}
+
+	if _, err := ValidatePostcode("12345", "UK"); err == nil {
+		t.Error("Expected error for unknown country")
+	}
+	if _, err := ValidatePostcode("12345"); err == nil {
+		t.Error("Expected error without countries")
+	}
+	if HasPostcode("HK") || HasPostcode("AE") || !HasPostcode("GB") || HasPostcode("XX") {
+		t.Error("Unexpected HasPostcode result")
+	}
+
+	// Every country of ISO 3166-1 has an entry, with or without postal codes.
+	formats := loadPostcodes()
+	for country := range loadISOTables().countries2 {
+		if _, ok := formats[country]; !ok {
+			t.Errorf("Expected postal code entry for %s", country)
+		}
+	}
+}
+
+func TestValidatePostcodeRules(t *testing.T) {
+	type Address struct {
+		Country  string
+		Postcode string `valid:"postcode=GB|US"`
+		Local    string `valid:"postcodeField=Country"`
+	}
+	if err := ValidateStruct(&Address{Postcode: "SW1A 1AA", Country: "US", Local: "94105"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := ValidateStruct(&Address{Country: "HK", Local: "n/a"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := ValidateStruct(&Address{Local: "94105"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Address{Postcode: "100080"})
+	if err == nil || err.(Errors)[0].Error() != "The Postcode must be a valid postal code." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err = ValidateStruct(&Address{Country: "GB", Local: "94105"})
+	if err == nil || err.(Errors)[0].Error() != "The Local must be a valid postal code." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err = ValidateStruct(&Address{Country: "ZZ", Local: "94105"})
+	if err == nil {
+		t.Error("Expected error for unknown country")
+	}
+
+	country := "CN"
+	if valid, err := validatePostcodeField(reflect.ValueOf("100080"), reflect.ValueOf(&country)); err != nil || !valid {
+		t.Errorf("Expected postal code of country pointer to be valid, got %v (%v)", valid, err)
+	}
+	if _, err := validatePostcodeField(reflect.ValueOf("100080"), reflect.Value{}); err == nil {
+		t.Error("Expected error for missing country field")
+	}
+	if _, err := validatePostcodeField(reflect.ValueOf("100080"), reflect.ValueOf(86)); err == nil {
+		t.Error("Expected error for non-string country field")
+	}
+}
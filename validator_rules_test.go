@@ -0,0 +1,466 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestAccepted(t *testing.T) {
+	type Accepted struct {
+		Terms  string `valid:"accepted"`
+		Agree  bool   `valid:"accepted"`
+		Opt    int    `valid:"accepted"`
+		Cookie *bool  `valid:"accepted"`
+	}
+	yes := true
+	no := false
+	var tests = []struct {
+		param    Accepted
+		expected bool
+	}{
+		{Accepted{Terms: "yes", Agree: true, Opt: 1}, true},
+		{Accepted{Terms: "on", Agree: true, Opt: 1}, true},
+		{Accepted{Terms: "TRUE", Agree: true, Opt: 1, Cookie: &yes}, true},
+		{Accepted{Terms: "1", Agree: true, Opt: 1}, true},
+		{Accepted{Terms: "no", Agree: true, Opt: 1}, false},
+		{Accepted{Terms: "", Agree: true, Opt: 1}, false},
+		{Accepted{Terms: "yes", Agree: false, Opt: 1}, false},
+		{Accepted{Terms: "yes", Agree: true, Opt: 2}, false},
+		{Accepted{Terms: "yes", Agree: true, Opt: 1, Cookie: &no}, false},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+}
+
+func TestBoolean(t *testing.T) {
+	var tests = []struct {
+		param    interface{}
+		expected bool
+	}{
+		{true, true},
+		{false, true},
+		{"true", true},
+		{"false", true},
+		{"1", true},
+		{"0", true},
+		{1, true},
+		{0, true},
+		{uint8(1), true},
+		{"yes", false},
+		{2, false},
+		{1.0, false},
+	}
+	for _, test := range tests {
+		if actual := ValidateBoolean(test.param); actual != test.expected {
+			t.Errorf("Expected ValidateBoolean(%#v) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	type Boolean struct {
+		Flag string `valid:"boolean"`
+	}
+	if err := ValidateStruct(&Boolean{Flag: "maybe"}); err == nil {
+		t.Error("Expected boolean to fail for \"maybe\"")
+	} else if err.Error() != "The Flag field must be true or false." {
+		t.Errorf("Unexpected message: %s", err)
+	}
+}
+
+func TestFilled(t *testing.T) {
+	type Filled struct {
+		Name  *string  `valid:"filled"`
+		Tags  []string `valid:"filled"`
+		Count *int     `valid:"filled"`
+	}
+	empty := ""
+	name := "name"
+	zero := 0
+	var tests = []struct {
+		param    Filled
+		expected bool
+	}{
+		{Filled{}, false},
+		{Filled{Tags: []string{"a"}}, true},
+		{Filled{Name: &name, Tags: []string{"a"}}, true},
+		{Filled{Name: &empty, Tags: []string{"a"}}, false},
+		{Filled{Tags: []string{"a"}, Count: &zero}, true},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+}
+
+func TestPresent(t *testing.T) {
+	type Present struct {
+		Name *string           `valid:"present"`
+		Meta map[string]string `valid:"present"`
+	}
+	empty := ""
+	var tests = []struct {
+		param    Present
+		expected bool
+	}{
+		{Present{}, false},
+		{Present{Name: &empty}, false},
+		{Present{Name: &empty, Meta: map[string]string{}}, true},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+
+	err := ValidateStruct(&Present{})
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The Name field must be present." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestDifferent(t *testing.T) {
+	type Different struct {
+		Password    string `valid:"different=OldPassword"`
+		OldPassword string
+		Tags        []string `valid:"different=OldTags"`
+		OldTags     []string
+	}
+	var tests = []struct {
+		param    Different
+		expected bool
+	}{
+		{Different{Password: "new", OldPassword: "old", Tags: []string{"a"}}, true},
+		{Different{Password: "same", OldPassword: "same", Tags: []string{"a"}}, false},
+		{Different{Password: "a", OldPassword: "b", Tags: []string{"a"}, OldTags: []string{"b"}}, true},
+		{Different{Password: "a", OldPassword: "b", Tags: []string{"a"}, OldTags: []string{"a"}}, false},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+
+	err := ValidateStruct(&Different{Password: "same", OldPassword: "same", Tags: []string{"a"}})
+	if err == nil || err.Error() != "The Password and OldPassword must be different." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if _, err := ValidateDifferent("a", 1); err == nil {
+		t.Error("Expected error for fields of different types")
+	}
+	if valid, err := ValidateDifferent(int64(1)<<53+1, float64(1<<53)); err != nil || !valid {
+		t.Errorf("Expected numbers of different kinds to be compared exactly, got %v %v", valid, err)
+	}
+	if valid, err := ValidateDifferent(uint8(3), int32(3)); err != nil || valid {
+		t.Errorf("Expected equal numbers of different kinds to fail, got %v %v", valid, err)
+	}
+
+	type NoParam struct {
+		Password string `valid:"different"`
+	}
+	err = ValidateStruct(NoParam{Password: "a"})
+	if err == nil || err.Error() != "validator: Different params length must be 1" {
+		t.Errorf("Expected a tag error for different without a field, got %v", err)
+	}
+}
+
+func TestConfirmed(t *testing.T) {
+	type Confirmed struct {
+		Password             string `valid:"confirmed"`
+		PasswordConfirmation string
+	}
+	var tests = []struct {
+		param    Confirmed
+		expected bool
+	}{
+		{Confirmed{Password: "secret", PasswordConfirmation: "secret"}, true},
+		{Confirmed{Password: "secret", PasswordConfirmation: "secreT"}, false},
+		{Confirmed{Password: "secret"}, false},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+
+	type MissingConfirmation struct {
+		Password string `valid:"confirmed"`
+	}
+	err := ValidateStruct(&MissingConfirmation{Password: "secret"})
+	if err == nil || err.Error() != "validator: MissingConfirmation.Password confirmed refers to unknown field PasswordConfirmation" {
+		t.Errorf("Expected a tag error when confirmation field is missing, got %v", err)
+	}
+}
+
+func TestUnknownFieldReferences(t *testing.T) {
+	type Address struct {
+		City string
+	}
+	type Signup struct {
+		Password        string `valid:"same=PasswrodConfirm"`
+		PasswordConfirm string
+	}
+	type Nested struct {
+		Home  *Address
+		Work  string `valid:"different=Home.City"`
+		Other string `valid:"different=Home.Town"`
+		Limit int    `valid:"lte=10:bytes"`
+	}
+	type Room struct {
+		Capacity int `valid:"gte=Guests"`
+	}
+
+	err := ValidateStruct(&Signup{Password: "a", PasswordConfirm: "a"})
+	if err == nil || err.Error() != "validator: Signup.Password same refers to unknown field PasswrodConfirm" {
+		t.Errorf("Expected a tag error for the mistyped field, got %v", err)
+	}
+	err = ValidateStruct(&Nested{Home: &Address{}})
+	if err == nil || err.Error() != "validator: Nested.Other different refers to unknown field Home.Town" {
+		t.Errorf("Expected a tag error for the unknown nested field, got %v", err)
+	}
+	err = ValidateStruct(&Room{Capacity: 1})
+	if err == nil || err.Error() != "validator: Room.Capacity gte refers to unknown field Guests" {
+		t.Errorf("Expected a tag error for the unknown compared field, got %v", err)
+	}
+}
+
+func TestDigits(t *testing.T) {
+	var tests = []struct {
+		param    interface{}
+		digits   string
+		expected bool
+	}{
+		{"1234", "4", true},
+		{"0123", "4", true},
+		{"123", "4", false},
+		{"12a4", "4", false},
+		{"", "4", false},
+		{1234, "4", true},
+		{uint(12345), "4", false},
+	}
+	for _, test := range tests {
+		actual, err := ValidateDigits(test.param, []string{test.digits})
+		if err != nil {
+			t.Errorf("Unexpected error for %#v: %v", test.param, err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateDigits(%#v, %s) to be %v, got %v", test.param, test.digits, test.expected, actual)
+		}
+	}
+
+	if _, err := ValidateDigits(1.5, []string{"2"}); err == nil {
+		t.Error("Expected error for float value")
+	}
+
+	type Digits struct {
+		Pin string `valid:"digits=6"`
+	}
+	err := ValidateStruct(&Digits{Pin: "123"})
+	if err == nil || err.Error() != "The Pin must be 6 digits." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestStringRule(t *testing.T) {
+	type StringRule struct {
+		Value interface{} `valid:"string"`
+	}
+	if err := ValidateStruct(&StringRule{Value: "text"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := ValidateStruct(&StringRule{Value: 10}); err == nil {
+		t.Error("Expected error for int value")
+	}
+}
+
+func TestValidateJSON(t *testing.T) {
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{`{"a":1}`, true},
+		{`[1,2,3]`, true},
+		{`"text"`, true},
+		{`{"a":}`, false},
+		{`{a:1}`, false},
+	}
+	for _, test := range tests {
+		if actual := ValidateJSON(test.param); actual != test.expected {
+			t.Errorf("Expected ValidateJSON(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestValidateTimezone(t *testing.T) {
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"UTC", true},
+		{"Asia/Hong_Kong", true},
+		{"America/New_York", true},
+		{"Local", false},
+		{"Mars/Olympus", false},
+		{"../etc/passwd", false},
+	}
+	for _, test := range tests {
+		if actual := ValidateTimezone(test.param); actual != test.expected {
+			t.Errorf("Expected ValidateTimezone(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestInArray(t *testing.T) {
+	type InArray struct {
+		Choice  string `valid:"inArray=Options"`
+		Options []string
+		Level   int `valid:"inArray=Levels"`
+		Levels  map[string]float64
+	}
+	var tests = []struct {
+		param    InArray
+		expected bool
+	}{
+		{InArray{Choice: "b", Options: []string{"a", "b"}, Level: 2, Levels: map[string]float64{"low": 1, "high": 2}}, true},
+		{InArray{Choice: "c", Options: []string{"a", "b"}, Level: 2, Levels: map[string]float64{"high": 2}}, false},
+		{InArray{Choice: "a", Options: []string{"a"}, Level: 3, Levels: map[string]float64{"high": 2}}, false},
+		{InArray{Choice: "a", Level: 1, Levels: map[string]float64{"low": 1}}, false},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+
+	err := ValidateStruct(&InArray{Choice: "c", Options: []string{"a"}, Level: 1, Levels: map[string]float64{"low": 1}})
+	if err == nil || err.Error() != "The Choice field does not exist in Options." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	var valueTests = []struct {
+		value    interface{}
+		values   interface{}
+		expected bool
+	}{
+		{"1", []int{1, 2}, true},
+		{1.0, []string{"1"}, true},
+		{"1e2", []uint{100}, true},
+		{int64(9007199254740993), []string{"9007199254740992"}, false},
+		{"0x10", []int{16}, false},
+		{true, []string{"true"}, true},
+		{"a", [2]string{"a", "b"}, true},
+	}
+	for _, test := range valueTests {
+		actual, err := ValidateInArray(test.value, test.values)
+		if err != nil {
+			t.Errorf("Unexpected error for %#v: %v", test.value, err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateInArray(%#v, %#v) to be %v, got %v", test.value, test.values, test.expected, actual)
+		}
+	}
+
+	if _, err := ValidateInArray("a", "abc"); err == nil {
+		t.Error("Expected error when the other field is not a collection")
+	}
+	if _, err := ValidateInArray([]string{"a"}, []string{"a"}); err == nil {
+		t.Error("Expected error when the value is a collection")
+	}
+}
+
+func TestNotInArray(t *testing.T) {
+	type NotInArray struct {
+		Username string `valid:"notInArray=Reserved"`
+		Reserved []string
+	}
+	if err := ValidateStruct(&NotInArray{Username: "sam", Reserved: []string{"admin", "root"}}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&NotInArray{Username: "admin", Reserved: []string{"admin", "root"}})
+	if err == nil || err.Error() != "The Username field must not exist in Reserved." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if valid, _ := ValidateNotInArray(2, []float64{1.5, 2.0}); valid {
+		t.Error("Expected 2 to be found in [1.5 2.0]")
+	}
+}
+
+func TestArrayRulesWithoutField(t *testing.T) {
+	type NoParams struct {
+		InArray    string   `valid:"inArray"`
+		NotInArray string   `valid:"notInArray"`
+		SubsetOf   []string `valid:"subsetOf"`
+		Same       string   `valid:"same"`
+	}
+	err := ValidateStruct(NoParams{InArray: "a", NotInArray: "b", SubsetOf: []string{"c"}, Same: "d"})
+	if err == nil {
+		t.Fatal("Expected tag errors")
+	}
+	expected := []string{
+		"validator: InArray params length must be 1",
+		"validator: NotInArray params length must be 1",
+		"validator: SubsetOf params length must be 1",
+		"validator: Same params length must be 1",
+	}
+	errs := err.(Errors)
+	if len(errs) != len(expected) {
+		t.Fatalf("Expected %d errors, got %v", len(expected), err)
+	}
+	for i, message := range expected {
+		if errs[i].Error() != message {
+			t.Errorf("Expected %q, got %q", message, errs[i].Error())
+		}
+	}
+}
+
+func TestSubsetOf(t *testing.T) {
+	type SubsetOf struct {
+		Selected []int `valid:"subsetOf=Allowed"`
+		Allowed  []string
+	}
+	var tests = []struct {
+		param    SubsetOf
+		expected bool
+	}{
+		{SubsetOf{Selected: []int{1, 3}, Allowed: []string{"1", "2", "3"}}, true},
+		{SubsetOf{Selected: []int{1, 4}, Allowed: []string{"1", "2", "3"}}, false},
+		{SubsetOf{Selected: []int{}, Allowed: []string{"1"}}, true},
+		{SubsetOf{Selected: []int{1}}, false},
+	}
+	for i, test := range tests {
+		err := ValidateStruct(&test.param)
+		actual := err == nil
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%T) Case %d to be %v, got %v: %v", test.param, i, test.expected, actual, err)
+		}
+	}
+
+	err := ValidateStruct(&SubsetOf{Selected: []int{4}, Allowed: []string{"1"}})
+	if err == nil || err.Error() != "The Selected field must only contain values from Allowed." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if valid, err := ValidateSubsetOf(map[string]string{"x": "a"}, []string{"a", "b"}); err != nil || !valid {
+		t.Errorf("Expected map values to be a subset, got %v (%v)", valid, err)
+	}
+	if _, err := ValidateSubsetOf("a", []string{"a"}); err == nil {
+		t.Error("Expected error when the value is not a collection")
+	}
+}
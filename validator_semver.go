@@ -0,0 +1,289 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// semVersion is a parsed semantic version. Build metadata does not take part in precedence and is dropped.
+type semVersion struct {
+	major, minor, patch uint64
+	pre                 []string
+}
+
+// parseSemver parses a semantic version as defined by semver.org, such as 1.2.3-rc.1+build.5.
+func parseSemver(str string) (semVersion, bool) {
+	m := rxSemver.FindStringSubmatch(str)
+	if m == nil {
+		return semVersion{}, false
+	}
+
+	var version semVersion
+	var err error
+	if version.major, err = strconv.ParseUint(m[1], 10, 64); err != nil {
+		return semVersion{}, false
+	}
+	if version.minor, err = strconv.ParseUint(m[2], 10, 64); err != nil {
+		return semVersion{}, false
+	}
+	if version.patch, err = strconv.ParseUint(m[3], 10, 64); err != nil {
+		return semVersion{}, false
+	}
+	if m[4] != "" {
+		version.pre = strings.Split(m[4], ".")
+	}
+	return version, true
+}
+
+// compare returns -1, 0 or 1 as v has lower, equal or higher precedence than other.
+func (v semVersion) compare(other semVersion) int {
+	for _, pair := range [][2]uint64{{v.major, other.major}, {v.minor, other.minor}, {v.patch, other.patch}} {
+		if pair[0] != pair[1] {
+			if pair[0] < pair[1] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A version without pre-release identifiers has higher precedence than one with them.
+	switch {
+	case len(v.pre) == 0 && len(other.pre) == 0:
+		return 0
+	case len(v.pre) == 0:
+		return 1
+	case len(other.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.pre) && i < len(other.pre); i++ {
+		if c := comparePrereleaseIdentifier(v.pre[i], other.pre[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(v.pre) < len(other.pre):
+		return -1
+	case len(v.pre) > len(other.pre):
+		return 1
+	}
+	return 0
+}
+
+// comparePrereleaseIdentifier compares numeric identifiers numerically, and below alphanumeric ones compared in ASCII order.
+func comparePrereleaseIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		if an == bn {
+			return 0
+		}
+		if an < bn {
+			return -1
+		}
+		return 1
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// semverComparator is a single bound of a range, such as >=1.2.0.
+type semverComparator struct {
+	operator string
+	version  semVersion
+}
+
+func (c semverComparator) check(version semVersion) bool {
+	n := version.compare(c.version)
+	switch c.operator {
+	case "<":
+		return n < 0
+	case "<=":
+		return n <= 0
+	case ">":
+		return n > 0
+	case ">=":
+		return n >= 0
+	default:
+		return n == 0
+	}
+}
+
+// parsePartialSemver parses a version in which trailing parts may be missing or wildcards, such as 1.2, 1.x or *.
+// It returns the version with the missing parts set to 0 and the number of parts given.
+func parsePartialSemver(str string) (semVersion, int, bool) {
+	if version, ok := parseSemver(str); ok {
+		return version, 3, true
+	}
+
+	var parts [3]uint64
+	n := 0
+	for i, part := range strings.Split(str, ".") {
+		if i == 3 {
+			return semVersion{}, 0, false
+		}
+		if part == "x" || part == "X" || part == "*" {
+			continue
+		}
+		value, err := strconv.ParseUint(part, 10, 64)
+		if err != nil || n != i || (len(part) > 1 && part[0] == '0') {
+			return semVersion{}, 0, false
+		}
+		parts[i] = value
+		n++
+	}
+	if n == 3 {
+		return semVersion{}, 0, false
+	}
+	return semVersion{major: parts[0], minor: parts[1], patch: parts[2]}, n, true
+}
+
+// bumpSemver returns the lowest version above every version matching the first n parts of version.
+func bumpSemver(version semVersion, n int) semVersion {
+	switch n {
+	case 1:
+		return semVersion{major: version.major + 1, pre: []string{"0"}}
+	case 2:
+		return semVersion{major: version.major, minor: version.minor + 1, pre: []string{"0"}}
+	default:
+		return semVersion{major: version.major, minor: version.minor, patch: version.patch + 1, pre: []string{"0"}}
+	}
+}
+
+// parseSemverRange parses a range of space separated constraints that must all hold, such as ">=1.2 <2".
+// Constraints use the operators =, <, <=, >, >=, ~ and ^ with partial versions, as in npm.
+func parseSemverRange(str string) ([]semverComparator, error) {
+	fields := strings.Fields(str)
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("validator: SemverRange invalid range %q", str)
+	}
+
+	var comparators []semverComparator
+	for i := 0; i < len(fields); i++ {
+		constraint := fields[i]
+		if strings.TrimLeft(constraint, "<>=~^") == "" && i+1 < len(fields) {
+			i++
+			constraint += fields[i]
+		}
+
+		operator := constraint[:len(constraint)-len(strings.TrimLeft(constraint, "<>=~^"))]
+		version, n, ok := parsePartialSemver(constraint[len(operator):])
+		if !ok {
+			return nil, fmt.Errorf("validator: SemverRange invalid constraint %q", constraint)
+		}
+
+		switch {
+		case n == 0 && (operator == "" || operator == "=" || operator == ">=" || operator == "<=" || operator == "~" || operator == "^"):
+			continue
+		case n == 0:
+			return nil, fmt.Errorf("validator: SemverRange invalid constraint %q", constraint)
+		}
+
+		switch operator {
+		case "", "=":
+			if n == 3 {
+				comparators = append(comparators, semverComparator{"=", version})
+			} else {
+				comparators = append(comparators, semverComparator{">=", version}, semverComparator{"<", bumpSemver(version, n)})
+			}
+		case ">=":
+			comparators = append(comparators, semverComparator{">=", version})
+		case ">":
+			if n == 3 {
+				comparators = append(comparators, semverComparator{">", version})
+			} else {
+				comparators = append(comparators, semverComparator{">=", bumpSemver(version, n)})
+			}
+		case "<":
+			if n < 3 {
+				version.pre = []string{"0"}
+			}
+			comparators = append(comparators, semverComparator{"<", version})
+		case "<=":
+			if n == 3 {
+				comparators = append(comparators, semverComparator{"<=", version})
+			} else {
+				comparators = append(comparators, semverComparator{"<", bumpSemver(version, n)})
+			}
+		case "~":
+			upper := 2
+			if n == 1 {
+				upper = 1
+			}
+			comparators = append(comparators, semverComparator{">=", version}, semverComparator{"<", bumpSemver(version, upper)})
+		case "^":
+			upper := 3
+			switch {
+			case version.major > 0 || n == 1:
+				upper = 1
+			case version.minor > 0 || n == 2:
+				upper = 2
+			}
+			comparators = append(comparators, semverComparator{">=", version}, semverComparator{"<", bumpSemver(version, upper)})
+		default:
+			return nil, fmt.Errorf("validator: SemverRange invalid operator %q", operator)
+		}
+	}
+	return comparators, nil
+}
+
+// ValidateSemver check if the string is a semantic version as defined by semver.org, such as 1.2.3 or 2.0.0-rc.1. Empty string is valid.
+func ValidateSemver(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	_, ok := parseSemver(str)
+	return ok
+}
+
+// ValidateSemverRange check if the string is a semantic version satisfying one of the ranges, such as ">=1.2 <2" or "^3.1".
+// Pre-release versions are compared by semver precedence. Empty string is valid.
+func ValidateSemverRange(str string, ranges ...string) (bool, error) {
+	if len(ranges) == 0 {
+		return false, fmt.Errorf("validator: SemverRange params length must be at least 1")
+	}
+	parsed := make([][]semverComparator, 0, len(ranges))
+	for _, r := range ranges {
+		comparators, err := parseSemverRange(r)
+		if err != nil {
+			return false, err
+		}
+		parsed = append(parsed, comparators)
+	}
+
+	if IsNull(str) {
+		return true, nil
+	}
+	version, ok := parseSemver(str)
+	if !ok {
+		return false, nil
+	}
+
+	for _, comparators := range parsed {
+		satisfied := true
+		for _, comparator := range comparators {
+			if !comparator.check(version) {
+				satisfied = false
+				break
+			}
+		}
+		if satisfied {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// validateSemverRange is the validation function for validating the string is a semantic version satisfying one of the ranges of params.
+func validateSemverRange(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: SemverRange unsupported type %s", v.Type())
+	}
+	return ValidateSemverRange(v.String(), params...)
+}
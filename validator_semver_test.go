@@ -0,0 +1,103 @@
+package validator
+
+import "testing"
+
+func TestValidateSemver(t *testing.T) {
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", true},
+		{"1.2.3", true},
+		{"0.0.0", true},
+		{"1.0.0-alpha.1", true},
+		{"1.0.0-0.3.7+build.11.e0f985a", true},
+		{"1.2", false},
+		{"v1.2.3", false},
+		{"01.2.3", false},
+		{"1.2.3-01", false},
+		{"1.2.3+", false},
+		{"99999999999999999999.0.0", false},
+	}
+	for _, test := range tests {
+		if actual := ValidateSemver(test.param); actual != test.expected {
+			t.Errorf("Expected ValidateSemver(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestSemverPrecedence(t *testing.T) {
+	ordered := []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.1.0", "2.0.0"}
+	for i := 1; i < len(ordered); i++ {
+		a, _ := parseSemver(ordered[i-1])
+		b, _ := parseSemver(ordered[i])
+		if a.compare(b) != -1 || b.compare(a) != 1 {
+			t.Errorf("Expected %s < %s", ordered[i-1], ordered[i])
+		}
+	}
+	a, _ := parseSemver("1.0.0+build.1")
+	b, _ := parseSemver("1.0.0+build.2")
+	if a.compare(b) != 0 {
+		t.Error("Expected build metadata to be ignored")
+	}
+}
+
+func TestValidateSemverRange(t *testing.T) {
+	var tests = []struct {
+		param    string
+		ranges   []string
+		expected bool
+	}{
+		{"", []string{">=1.2 <2"}, true},
+		{"1.2.0", []string{">=1.2 <2"}, true},
+		{"1.9.9", []string{">=1.2 <2"}, true},
+		{"2.0.0-rc.1", []string{">=1.2 <2"}, false},
+		{"2.0.0", []string{">=1.2 <2"}, false},
+		{"1.1.9", []string{">= 1.2", "^3"}, false},
+		{"3.4.0", []string{">=1.2 <2", "^3"}, true},
+		{"1.2.9", []string{"~1.2.3"}, true},
+		{"1.3.0", []string{"~1.2.3"}, false},
+		{"1.2.2", []string{"~1.2.3"}, false},
+		{"1.9.0", []string{"~1"}, true},
+		{"0.2.9", []string{"^0.2.3"}, true},
+		{"0.3.0", []string{"^0.2.3"}, false},
+		{"0.0.3", []string{"^0.0.3"}, true},
+		{"0.0.4", []string{"^0.0.3"}, false},
+		{"1.2.7", []string{"1.2.x"}, true},
+		{"1.3.0", []string{"1.2"}, false},
+		{"1.3.0", []string{">1.2"}, true},
+		{"1.2.9", []string{">1.2"}, false},
+		{"1.2.9", []string{"<=1.2"}, true},
+		{"1.3.0-alpha", []string{"<=1.2"}, false},
+		{"1.2.3", []string{"=1.2.3"}, true},
+		{"1.2.4", []string{"1.2.3"}, false},
+		{"7.0.0", []string{"*"}, true},
+		{"1.2", []string{"*"}, false},
+	}
+	for _, test := range tests {
+		actual, err := ValidateSemverRange(test.param, test.ranges...)
+		if err != nil {
+			t.Errorf("Unexpected error for %v: %v", test.ranges, err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateSemverRange(%q, %q) to be %v, got %v", test.param, test.ranges, test.expected, actual)
+		}
+	}
+
+	for _, r := range []string{"", ">=1.2.3.4", "=>1.2", "~>1.2", ">*", "1.x.2", "01.2"} {
+		if _, err := ValidateSemverRange("1.2.3", r); err == nil {
+			t.Errorf("Expected error for range %q", r)
+		}
+	}
+
+	type Plugin struct {
+		Version string `valid:"semverRange=>=1.2 <2|^3"`
+	}
+	if err := ValidateStruct(&Plugin{Version: "3.0.1"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Plugin{Version: "2.5.0"})
+	if err == nil || err.Error() != "The Version must be a version satisfying >=1.2 <2 || ^3." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -1,10 +1,16 @@
 package validator
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+	_ "time/tzdata" // embed the IANA database so timezone does not depend on the host
 	"unicode/utf8"
 )
 
@@ -26,17 +32,23 @@ func InString(str string, params []string) bool {
 
 // compareString determine if a comparison passes between the given values.
 func compareString(first string, second int64, operator string) (bool, error) {
+	return compareStringLength(first, second, operator, LengthRunes)
+}
+
+// compareStringLength determine if a comparison passes between the length of the string in the mode and the given value.
+func compareStringLength(first string, second int64, operator string, mode LengthMode) (bool, error) {
+	length := int64(StringLength(first, mode))
 	switch operator {
 	case "<":
-		return int64(utf8.RuneCountInString(first)) < second, nil
+		return length < second, nil
 	case ">":
-		return int64(utf8.RuneCountInString(first)) > second, nil
+		return length > second, nil
 	case "<=":
-		return int64(utf8.RuneCountInString(first)) <= second, nil
+		return length <= second, nil
 	case ">=":
-		return int64(utf8.RuneCountInString(first)) >= second, nil
+		return length >= second, nil
 	case "==":
-		return int64(utf8.RuneCountInString(first)) == second, nil
+		return length == second, nil
 	default:
 		return false, fmt.Errorf("validator: compareString unsupported operator %s", operator)
 	}
@@ -179,6 +191,105 @@ func ValidateUUID(str string) bool {
 	return rxUUID.MatchString(str)
 }
 
+// matchUUID check if the string matches the lowercase uuid pattern rx. The options "uppercase" and "braced"
+// also accept uppercase hexadecimal digits and the {...} form.
+func matchUUID(rx *regexp.Regexp, str string, options []string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if InString("braced", options) && len(str) == 38 && str[0] == '{' && str[37] == '}' {
+		str = str[1:37]
+	}
+	if InString("uppercase", options) {
+		str = strings.ToLower(str)
+	}
+	return rx.MatchString(str)
+}
+
+// ValidateUUID1 check if the string is an uuid1. Options are "uppercase" and "braced". Empty string is valid.
+func ValidateUUID1(str string, options ...string) bool {
+	return matchUUID(rxUUID1, str, options)
+}
+
+// ValidateUUID6 check if the string is an uuid6. Options are "uppercase" and "braced". Empty string is valid.
+func ValidateUUID6(str string, options ...string) bool {
+	return matchUUID(rxUUID6, str, options)
+}
+
+// ValidateUUID7 check if the string is an uuid7. Options are "uppercase" and "braced". Empty string is valid.
+func ValidateUUID7(str string, options ...string) bool {
+	return matchUUID(rxUUID7, str, options)
+}
+
+// ValidateUUIDAny check if the string is an RFC 9562 uuid of any version from 1 to 8.
+// Options are "uppercase" and "braced". Empty string is valid.
+func ValidateUUIDAny(str string, options ...string) bool {
+	return matchUUID(rxUUIDAny, str, options)
+}
+
+// ValidateULID check if the string is an ULID, 26 characters of Crockford's base32. Empty string is valid.
+func ValidateULID(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxULID.MatchString(str)
+}
+
+// validateBase64 check if the string decodes with encoding. Line breaks, which the decoder would skip, are rejected.
+func validateBase64(encoding *base64.Encoding, str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	if strings.ContainsAny(str, "\r\n") {
+		return false
+	}
+	_, err := encoding.Strict().DecodeString(str)
+	return err == nil
+}
+
+// ValidateBase64 check if the string is standard base64 with padding. Empty string is valid.
+func ValidateBase64(str string) bool {
+	return validateBase64(base64.StdEncoding, str)
+}
+
+// ValidateBase64URL check if the string is URL-safe base64 with padding. Empty string is valid.
+func ValidateBase64URL(str string) bool {
+	return validateBase64(base64.URLEncoding, str)
+}
+
+// ValidateBase64RawURL check if the string is URL-safe base64 without padding. Empty string is valid.
+func ValidateBase64RawURL(str string) bool {
+	return validateBase64(base64.RawURLEncoding, str)
+}
+
+// ValidateJWT check if the string is a JSON Web Token in compact form: a header with an alg, a JSON object payload
+// and a signature, each base64url encoded. The signature is not verified and may be empty. Empty string is valid.
+func ValidateJWT(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	parts := strings.Split(str, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return false
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || json.Unmarshal(data, &header) != nil || header.Alg == "" {
+		return false
+	}
+
+	var payload map[string]interface{}
+	data, err = base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || json.Unmarshal(data, &payload) != nil || payload == nil {
+		return false
+	}
+
+	return parts[2] == "" || ValidateBase64RawURL(parts[2])
+}
+
 // ValidateURL check if the string is an URL.
 func ValidateURL(str string) bool {
 	var i int
@@ -198,3 +309,214 @@ func ValidateURL(str string) bool {
 
 	return true
 }
+
+// ValidateJSON check if the string is valid JSON. Empty string is valid.
+func ValidateJSON(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return json.Valid([]byte(str))
+}
+
+// ValidateTimezone check if the string is a valid IANA time zone identifier. Empty string is valid.
+func ValidateTimezone(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	// time.LoadLocation maps "Local" to the host zone, which is not an identifier.
+	if str == "Local" {
+		return false
+	}
+	_, err := time.LoadLocation(str)
+	return err == nil
+}
+
+// creditCardBrands maps a card brand to the prefix ranges and lengths of its numbers.
+var creditCardBrands = []struct {
+	brand   string
+	prefix  [][2]int
+	lengths []int
+}{
+	{"amex", [][2]int{{34, 34}, {37, 37}}, []int{15}},
+	{"diners", [][2]int{{300, 305}, {36, 36}, {38, 38}}, []int{14}},
+	{"discover", [][2]int{{6011, 6011}, {65, 65}}, []int{16}},
+	{"jcb", [][2]int{{3528, 3589}}, []int{16}},
+	{"mastercard", [][2]int{{51, 55}, {2221, 2720}}, []int{16}},
+	{"unionpay", [][2]int{{62, 62}}, []int{16, 17, 18, 19}},
+	{"visa", [][2]int{{4, 4}}, []int{13, 16, 19}},
+}
+
+// normalizeCardNumber removes the spaces and dashes used to group the digits of a card number.
+func normalizeCardNumber(str string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(str)
+}
+
+// luhn reports whether the digits pass the Luhn checksum.
+func luhn(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if d < 0 || d > 9 {
+			return false
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// CreditCardBrand returns the brand of a credit card number: amex, diners, discover, jcb, mastercard, unionpay or visa.
+// It returns "" when the number is not a valid card number.
+func CreditCardBrand(str string) string {
+	number := normalizeCardNumber(str)
+	if !rxCreditCard.MatchString(number) || !luhn(number) {
+		return ""
+	}
+	for _, card := range creditCardBrands {
+		validLength := false
+		for _, length := range card.lengths {
+			validLength = validLength || len(number) == length
+		}
+		if !validLength {
+			continue
+		}
+		for _, prefix := range card.prefix {
+			p, _ := strconv.Atoi(number[:len(strconv.Itoa(prefix[0]))])
+			if p >= prefix[0] && p <= prefix[1] {
+				return card.brand
+			}
+		}
+	}
+	return ""
+}
+
+// ValidateCreditCard check if the string is a credit card number with a valid Luhn checksum.
+// Spaces and dashes between digits are ignored. When brands are given, the card must be one of them. Empty string is valid.
+func ValidateCreditCard(str string, brands ...string) bool {
+	if IsNull(str) {
+		return true
+	}
+	brand := CreditCardBrand(str)
+	if brand == "" {
+		return false
+	}
+	if len(brands) == 0 {
+		return true
+	}
+	for _, b := range brands {
+		if strings.EqualFold(b, brand) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeISBN removes the spaces and hyphens used to group the digits of an ISBN.
+func normalizeISBN(str string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(str)
+}
+
+// ValidateISBN10 check if the string is an ISBN-10 with a valid check digit. Empty string is valid.
+func ValidateISBN10(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	isbn := normalizeISBN(str)
+	if !rxISBN10.MatchString(isbn) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 10; i++ {
+		d := int(isbn[i] - '0')
+		if isbn[i] == 'X' {
+			d = 10
+		}
+		sum += (10 - i) * d
+	}
+	return sum%11 == 0
+}
+
+// ValidateISBN13 check if the string is an ISBN-13 with a valid check digit. Empty string is valid.
+func ValidateISBN13(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	isbn := normalizeISBN(str)
+	if !rxISBN13.MatchString(isbn) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d := int(isbn[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// ValidateISBN check if the string is an ISBN-10 or ISBN-13 with a valid check digit. Empty string is valid.
+func ValidateISBN(str string) bool {
+	return ValidateISBN10(str) || ValidateISBN13(str)
+}
+
+// ValidateHexadecimal check if the string is a hexadecimal number. Empty string is valid.
+func ValidateHexadecimal(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxHexadecimal.MatchString(str)
+}
+
+// ValidateHexColor check if the string is a hexadecimal color such as #fff or #ffffff. Empty string is valid.
+func ValidateHexColor(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxHexColor.MatchString(str)
+}
+
+// ValidateRGB check if the string is a CSS rgb() color. Empty string is valid.
+func ValidateRGB(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxRGBColor.MatchString(str)
+}
+
+// ValidateRGBA check if the string is a CSS rgba() color. Empty string is valid.
+func ValidateRGBA(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxRGBAColor.MatchString(str)
+}
+
+// ValidateHSL check if the string is a CSS hsl() color. Empty string is valid.
+func ValidateHSL(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxHSLColor.MatchString(str)
+}
+
+// ValidateHSLA check if the string is a CSS hsla() color. Empty string is valid.
+func ValidateHSLA(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	return rxHSLAColor.MatchString(str)
+}
+
+// ValidateColor check if the string is a hexadecimal, rgb(), rgba(), hsl() or hsla() color. Empty string is valid.
+func ValidateColor(str string) bool {
+	return ValidateHexColor(str) || ValidateRGB(str) || ValidateRGBA(str) || ValidateHSL(str) || ValidateHSLA(str)
+}
@@ -0,0 +1,190 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateCreditCard(t *testing.T) {
+	var tests = []struct {
+		param    string
+		brand    string
+		expected bool
+	}{
+		{"", "", true},
+		{"4111111111111111", "visa", true},
+		{"4111 1111 1111 1111", "visa", true},
+		{"4222222222222", "visa", true},
+		{"5555555555554444", "mastercard", true},
+		{"2223003122003222", "mastercard", true},
+		{"378282246310005", "amex", true},
+		{"3056-9309-0259-04", "diners", true},
+		{"6011111111111117", "discover", true},
+		{"3530111333300000", "jcb", true},
+		{"6200000000000005", "unionpay", true},
+		{"4111111111111112", "", false},
+		{"1234567812345670", "", false},
+		{"411111111111111a", "", false},
+	}
+	for _, test := range tests {
+		if actual := ValidateCreditCard(test.param); actual != test.expected {
+			t.Errorf("Expected ValidateCreditCard(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+		if test.param != "" {
+			if actual := CreditCardBrand(test.param); actual != test.brand {
+				t.Errorf("Expected CreditCardBrand(%q) to be %q, got %q", test.param, test.brand, actual)
+			}
+		}
+	}
+
+	if !ValidateCreditCard("4111111111111111", "mastercard", "Visa") {
+		t.Error("Expected visa card to match the visa brand")
+	}
+	if ValidateCreditCard("378282246310005", "visa", "mastercard") {
+		t.Error("Expected amex card not to match visa or mastercard")
+	}
+
+	type Payment struct {
+		Card    string `valid:"creditCard"`
+		Company string `valid:"creditCard=amex"`
+	}
+	if err := ValidateStruct(&Payment{Card: "4111111111111111", Company: "378282246310005"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Payment{Card: "4111111111111111", Company: "4111111111111111"})
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The Company must be a valid credit card number." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateISBN(t *testing.T) {
+	var tests = []struct {
+		param  string
+		isbn10 bool
+		isbn13 bool
+	}{
+		{"", true, true},
+		{"0306406152", true, false},
+		{"0-306-40615-2", true, false},
+		{"080442957X", true, false},
+		{"0306406153", false, false},
+		{"9780306406157", false, true},
+		{"978-0-306-40615-7", false, true},
+		{"9780306406158", false, false},
+		{"97803064061", false, false},
+	}
+	for _, test := range tests {
+		if actual := ValidateISBN10(test.param); actual != test.isbn10 {
+			t.Errorf("Expected ValidateISBN10(%q) to be %v, got %v", test.param, test.isbn10, actual)
+		}
+		if actual := ValidateISBN13(test.param); actual != test.isbn13 {
+			t.Errorf("Expected ValidateISBN13(%q) to be %v, got %v", test.param, test.isbn13, actual)
+		}
+		if actual := ValidateISBN(test.param); actual != (test.isbn10 || test.isbn13) {
+			t.Errorf("Expected ValidateISBN(%q) to be %v, got %v", test.param, test.isbn10 || test.isbn13, actual)
+		}
+	}
+}
+
+func TestValidateColors(t *testing.T) {
+	var tests = []struct {
+		param    string
+		validate func(string) bool
+		expected bool
+	}{
+		{"deadBEEF", ValidateHexadecimal, true},
+		{"0x1f", ValidateHexadecimal, false},
+		{"#fff", ValidateHexColor, true},
+		{"a1b2c3", ValidateHexColor, true},
+		{"#ffff", ValidateHexColor, false},
+		{"rgb(0, 128, 255)", ValidateRGB, true},
+		{"rgb(0,128,256)", ValidateRGB, false},
+		{"rgba(0, 128, 255, 0.5)", ValidateRGBA, true},
+		{"rgba(0, 128, 255, 2)", ValidateRGBA, false},
+		{"hsl(360, 100%, 50%)", ValidateHSL, true},
+		{"hsl(361, 100%, 50%)", ValidateHSL, false},
+		{"hsla(120, 50%, 50%, 0.3)", ValidateHSLA, true},
+		{"hsla(120, 50, 50, 0.3)", ValidateHSLA, false},
+		{"#123456", ValidateColor, true},
+		{"rgb(1,2,3)", ValidateColor, true},
+		{"hsla(1, 2%, 3%, 1)", ValidateColor, true},
+		{"red", ValidateColor, false},
+		{"", ValidateColor, true},
+	}
+	for _, test := range tests {
+		if actual := test.validate(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	type Theme struct {
+		Background string `valid:"color"`
+		Accent     string `valid:"hexColor"`
+	}
+	err := ValidateStruct(&Theme{Background: "#000", Accent: "rgb(1,2,3)"})
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The Accent must be a valid hexadecimal color." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestValidateIdentifiers(t *testing.T) {
+	const token = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4ifQ"
+	var tests = []struct {
+		param    string
+		validate func(string) bool
+		expected bool
+	}{
+		{"", func(s string) bool { return ValidateUUIDAny(s) }, true},
+		{"c232ab00-9414-11ec-b3c8-9f6bdeced846", func(s string) bool { return ValidateUUID1(s) }, true},
+		{"1ec9414c-232a-6b00-b3c8-9f6bdeced846", func(s string) bool { return ValidateUUID6(s) }, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", func(s string) bool { return ValidateUUID7(s) }, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", func(s string) bool { return ValidateUUID1(s) }, false},
+		{"017f22e2-79b0-7cc3-c8c4-dc0c0c07398f", func(s string) bool { return ValidateUUID7(s) }, false},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", func(s string) bool { return ValidateUUID7(s) }, false},
+		{"017F22E2-79B0-7CC3-98C4-DC0C0C07398F", func(s string) bool { return ValidateUUID7(s, "uppercase") }, true},
+		{"{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}", func(s string) bool { return ValidateUUIDAny(s) }, false},
+		{"{017f22e2-79b0-7cc3-98c4-dc0c0c07398f}", func(s string) bool { return ValidateUUIDAny(s, "braced") }, true},
+		{"{017F22E2-79B0-8CC3-98C4-DC0C0C07398F}", func(s string) bool { return ValidateUUIDAny(s, "braced", "uppercase") }, true},
+		{"00000000-0000-0000-0000-000000000000", func(s string) bool { return ValidateUUIDAny(s) }, false},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAV", ValidateULID, true},
+		{"01arz3ndektsv4rrffq69g5fav", ValidateULID, true},
+		{"81ARZ3NDEKTSV4RRFFQ69G5FAV", ValidateULID, false},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FAI", ValidateULID, false},
+		{"01ARZ3NDEKTSV4RRFFQ69G5FA", ValidateULID, false},
+		{"aGVsbG8=", ValidateBase64, true},
+		{"aGVsbG8", ValidateBase64, false},
+		{"aGVs\nbG8=", ValidateBase64, false},
+		{"aGVsbG9=", ValidateBase64, false},
+		{"-_-_", ValidateBase64, false},
+		{"-_-_", ValidateBase64URL, true},
+		{"aGVsbG8=", ValidateBase64URL, true},
+		{"aGVsbG8", ValidateBase64RawURL, true},
+		{"aGVsbG8=", ValidateBase64RawURL, false},
+		{token + ".c2lnbmF0dXJlLWJ5dGVz", ValidateJWT, true},
+		{token + ".", ValidateJWT, true},
+		{token, ValidateJWT, false},
+		{token + ".sig.extra", ValidateJWT, false},
+		{"eyJ0eXAiOiJKV1QifQ.eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4ifQ.", ValidateJWT, false},
+		{"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9.WzEsMl0.", ValidateJWT, false},
+		{"not.a.jwt", ValidateJWT, false},
+	}
+	for _, test := range tests {
+		if actual := test.validate(test.param); actual != test.expected {
+			t.Errorf("Expected validation of %q to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	type Record struct {
+		ID string `valid:"uuid7=uppercase|braced"`
+	}
+	if err := ValidateStruct(&Record{ID: "{017F22E2-79B0-7CC3-98C4-DC0C0C07398F}"}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := ValidateStruct(&Record{ID: "017f22e2-79b0-4cc3-98c4-dc0c0c07398f"})
+	if errs, ok := err.(Errors); !ok || errs[0].Error() != "The ID format is invalid." {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if valid, err := validateUUIDAny(reflect.ValueOf("x"), []string{"lowercase"}); valid || err == nil {
+		t.Error("Expected unknown uuid option to be an error")
+	}
+}
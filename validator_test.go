@@ -1838,3 +1838,18 @@ func TestCompareStringFunctionDirect(t *testing.T) {
 		t.Error("Expected error for invalid operator")
 	}
 }
+
+func TestValidateStructReportsEachFieldOnce(t *testing.T) {
+	type Item struct {
+		Name string `valid:"required"`
+	}
+	type Order struct {
+		ID    string `valid:"required"`
+		Note  string `valid:"required"`
+		Items []Item
+	}
+	err := ValidateStruct(Order{Items: []Item{{}}})
+	if err == nil || len(err.(Errors)) != 3 {
+		t.Errorf("Expected an error for each of the 3 fields, got %v", err)
+	}
+}
@@ -0,0 +1,103 @@
+package validator
+
+import (
+	"database/sql/driver"
+	"reflect"
+)
+
+// CustomTypeFunc returns the value held by a wrapper type, such as the string of a sql.NullString, or nil when it
+// holds none.
+type CustomTypeFunc func(field reflect.Value) interface{}
+
+var valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+
+// nullValue stands for the value of a wrapper holding none. It is empty for required and omitempty, and skips the
+// other rules as a nil pointer does.
+var nullValue = reflect.Zero(reflect.TypeOf((*interface{})(nil)).Elem())
+
+// RegisterTypeFunc registers fn to map the fields of the types, given as values such as Optional[string]{}, to the
+// values they hold before the rules run. Types implementing driver.Valuer, including all the sql.Null types, are mapped
+// with their Value method unless a func is registered for them.
+func (v *Validator) RegisterTypeFunc(fn CustomTypeFunc, types ...interface{}) {
+	if v.typeFuncs == nil {
+		v.typeFuncs = map[reflect.Type]CustomTypeFunc{}
+	}
+	for _, t := range types {
+		v.typeFuncs[reflect.TypeOf(t)] = fn
+	}
+}
+
+// isValuer reports whether values of the type are mapped with their driver.Valuer method. Structs with tagged fields
+// are validated as structs instead, so that types stored as JSON columns keep their rules.
+func isValuer(t reflect.Type) bool {
+	if !t.Implements(valuerType) && !reflect.PtrTo(t).Implements(valuerType) {
+		return false
+	}
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Tag.Get(tagName) != "" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// heldValue returns the reflection of the value held by a wrapper, or nullValue for nil.
+func heldValue(i interface{}) reflect.Value {
+	if i == nil {
+		return nullValue
+	}
+	return reflect.ValueOf(i)
+}
+
+// unwrapType returns the value held by a value of a registered type or a driver.Valuer, following a pointer to it,
+// and whether the value was a wrapper. Other values are returned as they are.
+func (v *Validator) unwrapType(value reflect.Value) (reflect.Value, bool, error) {
+	wrapper := value
+	if wrapper.Kind() == reflect.Ptr {
+		if wrapper.IsNil() {
+			return value, false, nil
+		}
+		wrapper = wrapper.Elem()
+	}
+	if !wrapper.CanInterface() {
+		return value, false, nil
+	}
+
+	if fn, ok := v.typeFuncs[wrapper.Type()]; ok {
+		return heldValue(fn(wrapper)), true, nil
+	}
+	if !isValuer(wrapper.Type()) {
+		return value, false, nil
+	}
+
+	valuer, ok := wrapper.Interface().(driver.Valuer)
+	if !ok {
+		// The method has a pointer receiver.
+		p := reflect.New(wrapper.Type())
+		p.Elem().Set(wrapper)
+		valuer = p.Interface().(driver.Valuer)
+	}
+	held, err := valuer.Value()
+	if err != nil {
+		return value, false, err
+	}
+	return heldValue(held), true, nil
+}
+
+// unwrapped returns the field with the messages of its size rules named after the type of the value its wrapper holds.
+func (f *field) unwrapped(t reflect.Type) *field {
+	uf := *f
+	uf.validTags = make(otherValidTags, len(f.validTags))
+	for i, tag := range f.validTags {
+		retagged := *tag
+		if lengthRules[tag.name] {
+			_, retagged.messageName = f.parseLengthMode(f.parseMessageName(tag.name, t), tag.params)
+			retagged.numeric = false
+		}
+		uf.validTags[i] = &retagged
+	}
+	uf.validTags.markNumeric(t)
+	return &uf
+}
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type optional[T any] struct {
+	value T
+	set   bool
+}
+
+type brokenValuer struct{}
+
+func (brokenValuer) Value() (driver.Value, error) {
+	return nil, errors.New("broken")
+}
+
+type jsonColumn struct {
+	Name string `valid:"required"`
+}
+
+func (c *jsonColumn) Value() (driver.Value, error) {
+	return c.Name, nil
+}
+
+func TestValidateSQLNullTypes(t *testing.T) {
+	type Account struct {
+		Email    sql.NullString  `valid:"required,email"`
+		Nickname sql.NullString  `valid:"omitempty,min=3"`
+		Age      sql.NullInt64   `valid:"omitempty,between=18|130"`
+		Score    *sql.NullInt32  `valid:"max=100"`
+		Balance  sql.NullFloat64 `valid:"gte=0"`
+		Amount   sql.NullString  `valid:"decimal=10,max=1000"`
+	}
+
+	var tests = []struct {
+		param    Account
+		expected string
+	}{
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Score: &sql.NullInt32{Int32: 100, Valid: true}}, ""},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: false}}, "The Email field is required."},
+		{Account{Email: sql.NullString{String: "invalid", Valid: true}}, "The Email must be a valid email address."},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Nickname: sql.NullString{String: "ab", Valid: true}}, "The Nickname must be at least 3 characters."},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Nickname: sql.NullString{String: "ab", Valid: false}}, ""},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Age: sql.NullInt64{Int64: 12, Valid: true}}, "The Age must be between 18 and 130."},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Score: &sql.NullInt32{Int32: 101, Valid: true}}, "The Score may not be greater than 100."},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Balance: sql.NullFloat64{Float64: -1, Valid: true}}, "The Balance must be greater than or equal 0."},
+		{Account{Email: sql.NullString{String: "a@example.com", Valid: true}, Amount: sql.NullString{String: "1001", Valid: true}}, "The Amount may not be greater than 1000."},
+	}
+	for _, test := range tests {
+		err := ValidateStruct(test.param)
+		actual := ""
+		if err != nil {
+			actual = err.(Errors)[0].Error()
+		}
+		if actual != test.expected {
+			t.Errorf("Expected ValidateStruct(%+v) to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestRegisterTypeFunc(t *testing.T) {
+	type Profile struct {
+		Name    optional[string] `valid:"required,max=5"`
+		Website optional[string] `valid:"omitempty,url"`
+	}
+
+	v := New()
+	v.RegisterTypeFunc(func(field reflect.Value) interface{} {
+		if o := field.Interface().(optional[string]); o.set {
+			return o.value
+		}
+		return nil
+	}, optional[string]{})
+
+	if err := v.ValidateStruct(Profile{Name: optional[string]{value: "alice", set: true}}, nil, nil); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	var tests = []struct {
+		param    Profile
+		expected string
+	}{
+		{Profile{}, "The Name field is required."},
+		{Profile{Name: optional[string]{value: "alexander", set: true}}, "The Name may not be greater than 5 characters."},
+		{Profile{Name: optional[string]{value: "alice", set: true}, Website: optional[string]{value: "not a url", set: true}}, "The Website format is invalid."},
+	}
+	for _, test := range tests {
+		err := v.ValidateStruct(test.param, nil, nil)
+		if err == nil || err.(Errors)[0].Error() != test.expected {
+			t.Errorf("Expected %q, got %v", test.expected, err)
+		}
+	}
+}
+
+func TestValuerTypes(t *testing.T) {
+	type Record struct {
+		Broken brokenValuer `valid:"max=3"`
+	}
+	err := ValidateStruct(Record{})
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	if fieldErr := err.(Errors)[0].(*FieldError); fieldErr.FuncError == nil || fieldErr.FuncError.Error() != "broken" {
+		t.Errorf("Expected the error of Value, got %v", fieldErr.FuncError)
+	}
+
+	type Document struct {
+		Column jsonColumn `valid:"max=3"`
+	}
+	err = ValidateStruct(Document{Column: jsonColumn{}})
+	if err == nil || err.(Errors)[0].Error() != "The Name field is required." {
+		t.Errorf("Expected tagged structs to be validated as structs, got %v", err)
+	}
+}
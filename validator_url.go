@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// urlResolveTimeout bounds the host lookups of the safeUrl rule.
+const urlResolveTimeout = 5 * time.Second
+
+// Resolver looks up the IP addresses of a host. *net.Resolver implements it.
+type Resolver interface {
+	LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error)
+}
+
+// DefaultResolver resolves the hostnames of the safeUrl rule. Set it to a StaticResolver in tests,
+// or to nil to check only IP literals without any lookup.
+var DefaultResolver Resolver = net.DefaultResolver
+
+// StaticResolver is an in-memory Resolver mapping hosts to their addresses.
+type StaticResolver map[string][]netip.Addr
+
+// LookupNetIP returns the addresses of host, or a not found error when it is not in the map.
+func (r StaticResolver) LookupNetIP(ctx context.Context, network, host string) ([]netip.Addr, error) {
+	addrs, ok := r[strings.ToLower(host)]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addrs, nil
+}
+
+// parseURL parses str as ValidateURL does, ignoring the fragment.
+func parseURL(str string) (*url.URL, bool) {
+	if i := strings.Index(str, "#"); i > -1 {
+		str = str[:i]
+	}
+	u, err := url.ParseRequestURI(str)
+	if err != nil || u.Scheme == "" {
+		return nil, false
+	}
+	return u, true
+}
+
+// ValidateURLSchemes check if the string is an URL with one of the schemes, such as https or wss. Empty string is valid.
+func ValidateURLSchemes(str string, schemes ...string) bool {
+	if IsNull(str) {
+		return true
+	}
+	u, ok := parseURL(str)
+	if !ok {
+		return false
+	}
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateURLRequireScheme check if the string is an absolute URL with a scheme and a host, such as https://example.com.
+// Strings such as localhost:8080, which ValidateURL reads as a scheme and an opaque path, are rejected. Empty string is valid.
+func ValidateURLRequireScheme(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	u, ok := parseURL(str)
+	return ok && u.Host != "" && u.Hostname() != ""
+}
+
+// matchHost reports whether host matches pattern. A pattern starting with "*." matches the subdomains of the rest.
+func matchHost(host, pattern string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	pattern = strings.TrimSuffix(strings.ToLower(pattern), ".")
+	if strings.HasPrefix(pattern, "*.") {
+		return strings.HasSuffix(host, pattern[1:]) && len(host) > len(pattern)-1
+	}
+	return host == pattern
+}
+
+// ValidateURLHost check if the string is an URL whose host matches one of the hosts.
+// A host starting with "*." matches any subdomain, but not the domain itself. Empty string is valid.
+func ValidateURLHost(str string, hosts ...string) bool {
+	if IsNull(str) {
+		return true
+	}
+	u, ok := parseURL(str)
+	if !ok || u.Hostname() == "" {
+		return false
+	}
+	for _, host := range hosts {
+		if matchHost(u.Hostname(), host) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateHTTPURL check if the string is an http or https URL with a host. Empty string is valid.
+func ValidateHTTPURL(str string) bool {
+	return ValidateURLSchemes(str, "http", "https") && ValidateURLRequireScheme(str)
+}
+
+// isUnsafeAddr reports whether a request to addr could reach anything but a public host, such as the local host or
+// network, a shared or multicast range or the broadcast address.
+func isUnsafeAddr(addr netip.Addr) bool {
+	return !isPublicAddr(addr.Unmap())
+}
+
+// ValidateSafeURL check if the string is an http or https URL that is safe to request from the server.
+// The URL must have a host and no userinfo, and the host must not be a private, loopback, link-local or other
+// non-public IP address.
+// Hostnames are looked up with DefaultResolver and rejected when they cannot be resolved or resolve to such an address.
+// Empty string is valid.
+func ValidateSafeURL(str string) bool {
+	if IsNull(str) {
+		return true
+	}
+	u, err := url.Parse(str)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return false
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if addr, ok := parseAddr(host); ok {
+		return !isUnsafeAddr(addr)
+	}
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") || !ValidateFQDN(host) {
+		return false
+	}
+
+	if DefaultResolver == nil {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), urlResolveTimeout)
+	defer cancel()
+	addrs, err := DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return false
+	}
+	for _, addr := range addrs {
+		if isUnsafeAddr(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateURL is the validation function for validating the string is an URL, with one of the schemes of params if
+// there are any.
+func validateURL(v reflect.Value, params []string) (bool, error) {
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: URL unsupported type %s", v.Type())
+	}
+	if len(params) == 0 {
+		return ValidateURL(v.String()), nil
+	}
+	return ValidateURLSchemes(v.String(), params...), nil
+}
+
+// validateURLHost is the validation function for validating the string is an URL whose host matches one of params.
+func validateURLHost(v reflect.Value, params []string) (bool, error) {
+	if len(params) == 0 {
+		return false, fmt.Errorf("validator: URLHost params length must be at least 1")
+	}
+	if v.Kind() != reflect.String {
+		return false, fmt.Errorf("validator: URLHost unsupported type %s", v.Type())
+	}
+	return ValidateURLHost(v.String(), params...), nil
+}